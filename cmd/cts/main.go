@@ -1,34 +1,197 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"math/rand"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/infrastructure/file_store"
+	"github.com/yanosea/cts/internal/infrastructure/line_screen"
 	"github.com/yanosea/cts/internal/infrastructure/tcell_screen"
+	"github.com/yanosea/cts/internal/interface/cli"
 	"github.com/yanosea/cts/internal/interface/ui"
 	"github.com/yanosea/cts/internal/usecase"
 )
 
 func main() {
-	// 乱数の種を設定するのじゃ
-	rand.New(rand.NewSource(time.Now().UnixNano()))
+	// 表示する言語は、まず環境変数から決めるのじゃ
+	i18n.SetLang(i18n.DetectLang(os.Getenv))
 
-	// スクリーンアダプタを初期化するのじゃ
-	screenAdapter, err := tcell_screen.NewScreenAdapter()
+	// データの保存先を決めるのじゃ
+	dataDir, err := file_store.DataDir()
+	if err != nil {
+		exitWithError(err)
+	}
+	historyStore := file_store.NewHistoryStore(dataDir)
+	historyInteractor := usecase.NewHistoryInteractor(historyStore)
+	profileStore := file_store.NewProfileStore(dataDir)
+	profileInteractor := usecase.NewProfileInteractor(profileStore)
+	leaderboardStore := file_store.NewLeaderboardStore(dataDir)
+	dailyInteractor := usecase.NewDailyInteractor(leaderboardStore, file_store.LeaderboardCodec{})
+
+	// 設定ファイルを読み込むのじゃ。言語の設定があれば環境変数よりそちらを優先するのじゃ
+	configDir, err := file_store.ConfigDir()
+	if err != nil {
+		exitWithError(err)
+	}
+	settingsInteractor := usecase.NewSettingsInteractor(file_store.NewSettingsStore(configDir))
+	settings, err := settingsInteractor.GetSettings()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "エラー: %v\n", err)
-		os.Exit(1)
+		exitWithError(err)
+	}
+	if settings.Language != "" {
+		lang, err := i18n.ParseLang(settings.Language)
+		if err != nil {
+			exitWithError(err)
+		}
+		i18n.SetLang(lang)
+	}
+
+	// サブコマンドが指定されていればそちらを実行するのじゃ
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "history":
+			if err := cli.NewHistoryCommand(historyInteractor, os.Stdout).Run(os.Args[2:]); err != nil {
+				exitWithError(err)
+			}
+			return
+		case "daily":
+			if err := cli.NewDailyCommand(dailyInteractor, os.Stdout).Run(os.Args[2:]); err != nil {
+				exitWithError(err)
+			}
+			return
+		case "profile":
+			if err := cli.NewProfileCommand(profileInteractor, os.Stdout).Run(os.Args[2:]); err != nil {
+				exitWithError(err)
+			}
+			return
+		}
+	}
+
+	// コマンドラインの指定を読み込むのじゃ
+	ascension := flag.Int("ascension", 0, i18n.T("flag.ascension"))
+	character := flag.String("character", "", i18n.T("flag.character"))
+	daily := flag.Bool("daily", false, i18n.T("flag.daily"))
+	name := flag.String("name", os.Getenv("USER"), i18n.T("flag.name"))
+	themeName := flag.String("theme", "", i18n.T("flag.theme", tcell_screen.ThemeNames()))
+	keymapName := flag.String("keymap", "", i18n.T("flag.keymap", ui.KeymapPresetNames()))
+	animationName := flag.String("animation", "", i18n.T("flag.animation", ui.AnimationSpeedNames()))
+	langName := flag.String("lang", "", i18n.T("flag.lang", i18n.LangNames()))
+	frameRate := flag.Int("fps", 0, i18n.T("flag.fps", ui.FrameRates()))
+	lineMode := flag.Bool("line", false, i18n.T("flag.line"))
+	flag.Parse()
+
+	// コマンドラインの指定があれば設定ファイルよりそちらを優先するのじゃ
+	if *langName != "" {
+		lang, err := i18n.ParseLang(*langName)
+		if err != nil {
+			exitWithError(err)
+		}
+		i18n.SetLang(lang)
+		settings.Language = string(lang)
+	}
+	if *themeName != "" {
+		settings.Theme = *themeName
+	}
+	if *keymapName != "" {
+		settings.Keymap = *keymapName
+	}
+	if *animationName != "" {
+		settings.Animation = *animationName
+	}
+	if *frameRate != 0 {
+		settings.FrameRate = *frameRate
+	}
+	// 行単位で遊ぶときはアニメーションを見せないのじゃ
+	if *lineMode {
+		settings.Animation = ui.AnimationOff.String()
 	}
-	defer screenAdapter.Cleanup()
 
-	// ゲームのインタラクタを初期化するのじゃ
-	gameInteractor := usecase.NewGameInteractor()
+	// メニューのインタラクタを初期化するのじゃ
+	// 解放されたアセンションとキャラクターしか選べないのじゃ
+	menuInteractor := usecase.NewMenuInteractor(usecase.Repositories{
+		History:     historyStore,
+		Profile:     profileStore,
+		Leaderboard: leaderboardStore,
+		Save:        file_store.NewSaveStore(dataDir),
+		CombatLog:   file_store.NewCombatLogStore(dataDir),
+	}, *name)
+	if err := menuInteractor.SetAscension(*ascension); err != nil {
+		exitWithError(err)
+	}
+	if err := menuInteractor.SetCharacter(*character); err != nil {
+		exitWithError(err)
+	}
 
-	// ゲームコントローラを初期化するのじゃ
-	gameController := ui.NewGameController(screenAdapter, gameInteractor)
+	// デイリーチャレンジはすぐに始めるのじゃ
+	var dailyRun *usecase.GameInteractor
+	if *daily {
+		dailyRun, err = menuInteractor.NewDailyRun(entities.NewDailyChallenge(time.Now()))
+		if err != nil {
+			exitWithError(err)
+		}
+	}
+
+	// 配色のテーマを読み込むのじゃ
+	themeFile := filepath.Join(dataDir, "theme.json")
+	theme, err := tcell_screen.LoadTheme(settings.Theme, themeFile)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	// キーの割り当てを読み込むのじゃ。重なっているキーがあれば始めないのじゃ
+	keymapFile := filepath.Join(dataDir, "keymap.json")
+	loadKeymap := func(preset string) (ui.Keymap, error) {
+		return file_store.LoadKeymap(keymapFile, preset)
+	}
+	keymap, err := loadKeymap(settings.Keymap)
+	if err != nil {
+		exitWithError(err)
+	}
+	bindings, err := keymap.Bindings()
+	if err != nil {
+		exitWithError(err)
+	}
+
+	// アニメーションの速さを確かめるのじゃ
+	if _, err := ui.ParseAnimationSpeed(settings.Animation); err != nil {
+		exitWithError(err)
+	}
+
+	// スクリーンアダプタを初期化するのじゃ
+	// 行単位で遊ぶときは標準入出力でやりとりするのじゃ
+	var screen ui.ScreenPort
+	if *lineMode {
+		screen = line_screen.NewScreenAdapter(os.Stdin, os.Stdout)
+	} else {
+		screenAdapter, err := tcell_screen.NewScreenAdapter(theme, themeFile, bindings)
+		if err != nil {
+			exitWithError(err)
+		}
+		screen = screenAdapter
+	}
+	defer screen.Cleanup()
+
+	// ゲームコントローラを初期化し、設定を当てはめるのじゃ
+	gameController := ui.NewGameController(screen, menuInteractor, historyInteractor, profileInteractor, keymap)
+	if err := gameController.UseSettings(settingsInteractor, loadKeymap, settings); err != nil {
+		screen.Cleanup()
+		exitWithError(err)
+	}
+	if dailyRun != nil {
+		gameController.StartRun(dailyRun)
+	}
 
 	// ゲームを開始するのじゃ
 	gameController.StartGame()
 }
+
+// exitWithError はエラーを表示して終了するのじゃ
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, i18n.T("error.prefix", err))
+	os.Exit(1)
+}
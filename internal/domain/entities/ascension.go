@@ -0,0 +1,108 @@
+package entities
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// MaxAscensionLevel はアセンションの最大段階じゃ
+const MaxAscensionLevel = 20
+
+// AscensionModifier はアセンションの段階ごとに積み重なる変化を表す型じゃ
+type AscensionModifier int
+
+// アセンションの変化の定義
+const (
+	AscensionMoreElites AscensionModifier = iota
+	AscensionStrongerEnemies
+	AscensionStrongerElites
+	AscensionStrongerBoss
+	AscensionTougherEnemies
+	AscensionTougherElites
+	AscensionTougherBoss
+	AscensionLessHealing
+	AscensionInjuredStart
+	AscensionStartingCurse
+	AscensionFewerPotionSlots
+	AscensionLessGold
+	AscensionLowerMaxHealth
+)
+
+// ascensionLevels は段階ごとに加わる変化じゃ。添字0がアセンション1じゃ
+var ascensionLevels = [MaxAscensionLevel]AscensionModifier{
+	AscensionMoreElites,
+	AscensionStrongerEnemies,
+	AscensionStrongerElites,
+	AscensionStrongerBoss,
+	AscensionLessHealing,
+	AscensionInjuredStart,
+	AscensionTougherEnemies,
+	AscensionTougherElites,
+	AscensionTougherBoss,
+	AscensionStartingCurse,
+	AscensionFewerPotionSlots,
+	AscensionLessGold,
+	AscensionLowerMaxHealth,
+	AscensionMoreElites,
+	AscensionStrongerEnemies,
+	AscensionLessHealing,
+	AscensionStrongerElites,
+	AscensionTougherBoss,
+	AscensionLowerMaxHealth,
+	AscensionStrongerBoss,
+}
+
+// Ascension はランの難易度の段階を表す構造体じゃ
+type Ascension struct {
+	Level int
+}
+
+// NewAscension は指定された段階のAscensionを生成するのじゃ
+// 範囲外の段階は0から最大段階までに収めるのじゃ
+func NewAscension(level int) Ascension {
+	if level < 0 {
+		level = 0
+	}
+	if level > MaxAscensionLevel {
+		level = MaxAscensionLevel
+	}
+	return Ascension{Level: level}
+}
+
+// Count は現在の段階までにその変化が何回積み重なっているかを返すのじゃ
+func (a Ascension) Count(modifier AscensionModifier) int {
+	count := 0
+	for level := 1; level <= a.Level; level++ {
+		if ascensionLevels[level-1] == modifier {
+			count++
+		}
+	}
+	return count
+}
+
+// ascensionDescriptionKeys は変化ごとの説明のキーじゃ
+var ascensionDescriptionKeys = map[AscensionModifier]string{
+	AscensionMoreElites:       "ascension.more_elites",
+	AscensionStrongerEnemies:  "ascension.stronger_enemies",
+	AscensionStrongerElites:   "ascension.stronger_elites",
+	AscensionStrongerBoss:     "ascension.stronger_boss",
+	AscensionTougherEnemies:   "ascension.tougher_enemies",
+	AscensionTougherElites:    "ascension.tougher_elites",
+	AscensionTougherBoss:      "ascension.tougher_boss",
+	AscensionLessHealing:      "ascension.less_healing",
+	AscensionInjuredStart:     "ascension.injured_start",
+	AscensionStartingCurse:    "ascension.starting_curse",
+	AscensionFewerPotionSlots: "ascension.fewer_potion_slots",
+	AscensionLessGold:         "ascension.less_gold",
+	AscensionLowerMaxHealth:   "ascension.lower_max_health",
+}
+
+// GetAscensionDescription は指定された段階で加わる変化の説明を返すのじゃ
+func GetAscensionDescription(level int) i18n.Message {
+	if level < 1 || level > MaxAscensionLevel {
+		return i18n.Msg("ascension.none")
+	}
+	if key, ok := ascensionDescriptionKeys[ascensionLevels[level-1]]; ok {
+		return i18n.Msg(key)
+	}
+	return i18n.Msg("common.unknown")
+}
@@ -1,5 +1,9 @@
 package entities
 
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
 // CardEffect はカードの効果を表す関数型じゃ
 type CardEffect func(*Player, *Enemy)
 
@@ -8,7 +12,8 @@ type CardRarity int
 
 // カードレア度の定義
 const (
-	Common CardRarity = iota
+	Basic CardRarity = iota // 初期デッキ専用のカードじゃ
+	Common
 	Uncommon
 	Rare
 )
@@ -21,137 +26,765 @@ const (
 	AttackCard CardType = iota
 	SkillCard
 	PowerCard
+	CurseCard
+)
+
+// CardColor はカードの色を表す型じゃ
+// キャラクターごとに報酬や店に出てくる色が決まっておるのじゃ
+type CardColor int
+
+// カード色の定義
+const (
+	Colorless CardColor = iota // どのキャラクターでも手に入る無色のカードじゃ
+	Red                        // アイアンクラッドのカードじゃ
+	Green                      // サイレントのカードじゃ
 )
 
 // Card はカードの基本構造を定義じゃ
 type Card struct {
-	Name        string
-	Description string
+	ID          string // カードの種類を識別するIDじゃ
+	Name        i18n.Message
+	Description i18n.Message
 	EnergyCost  int
 	Rarity      CardRarity
 	Type        CardType
+	Color       CardColor
+	Unplayable  bool       // trueなら手札から使えないカードじゃ
+	Exhaust     bool       // trueなら使うと廃棄されるカードじゃ
+	Upgraded    bool       // trueならアップグレード済みのカードじゃ
+	Damage      int        // 1回あたりの基本のダメージじゃ。筋力などの補正を加える前の値じゃ
+	Block       int        // 基本のブロック値じゃ。敏捷性の補正を加える前の値じゃ
 	Effect      CardEffect // カードの効果を実装する関数じゃ
 }
 
+// カードのレア度の表示名のキーじゃ
+var cardRarityKeys = map[CardRarity]string{
+	Basic:    "card.rarity.basic",
+	Common:   "card.rarity.common",
+	Uncommon: "card.rarity.uncommon",
+	Rare:     "card.rarity.rare",
+}
+
+// カードの種類の表示名のキーじゃ
+var cardTypeKeys = map[CardType]string{
+	AttackCard: "card.type.attack",
+	SkillCard:  "card.type.skill",
+	PowerCard:  "card.type.power",
+	CurseCard:  "card.type.curse",
+}
+
+// GetRarityName はカードのレア度の表示名を返すのじゃ
+func (c *Card) GetRarityName() i18n.Message {
+	return i18n.Msg(cardRarityKeys[c.Rarity])
+}
+
+// GetTypeName はカードの種類の表示名を返すのじゃ
+func (c *Card) GetTypeName() i18n.Message {
+	return i18n.Msg(cardTypeKeys[c.Type])
+}
+
+// CardStats はカードの数値をまとめたものじゃ
+// アップグレードの内容もこの型で元の数値に足す差分として表すのじゃ
+type CardStats struct {
+	Cost   int // エナジーコストじゃ
+	Damage int // 1回あたりの基本のダメージじゃ
+	Block  int // 基本のブロック値じゃ
+	Magic  int // 脆弱や弱体化、筋力、引く枚数などカードごとに意味の変わる数値じゃ
+}
+
+// add は差分を足した数値を返すのじゃ
+func (s CardStats) add(d CardStats) CardStats {
+	return CardStats{
+		Cost:   s.Cost + d.Cost,
+		Damage: s.Damage + d.Damage,
+		Block:  s.Block + d.Block,
+		Magic:  s.Magic + d.Magic,
+	}
+}
+
+// cardDefinition はカードの定義じゃ
+// 元のカードもアップグレードしたカードも同じbuildに数値を渡して生成するのじゃ
+type cardDefinition struct {
+	id      string
+	base    CardStats  // 元のカードの数値じゃ
+	upgrade *CardStats // アップグレードで足す差分じゃ。nilならアップグレードできないのじゃ
+	// build は数値からカードを組み立てるのじゃ
+	// upgradedは廃棄が外れるなど、数値で表せない違いのあるカードだけが使うのじゃ
+	build func(s CardStats, upgraded bool) Card
+}
+
+// create は定義からカードを生成するのじゃ
+// upgradedがtrueならアップグレードの差分を足した数値で生成するのじゃ
+func (d cardDefinition) create(upgraded bool) Card {
+	stats := d.base
+	if upgraded {
+		stats = stats.add(*d.upgrade)
+	}
+	card := d.build(stats, upgraded)
+	card.ID = d.id
+	card.EnergyCost = stats.Cost
+	card.Damage = stats.Damage
+	card.Block = stats.Block
+	if upgraded {
+		card.Name = i18n.Msg("card.upgraded_name", card.Name)
+		card.Upgraded = true
+	}
+	return card
+}
+
 // CreateStrikeCard は基本的な攻撃カードを生成するのじゃ
 func CreateStrikeCard() Card {
-	return Card{
-		Name:        "ストライク",
-		Description: "6ダメージを与える",
-		EnergyCost:  1,
-		Rarity:      Common,
-		Type:        AttackCard,
-		Effect: func(p *Player, e *Enemy) {
-			e.ApplyDamage(6)
-		},
-	}
+	return strikeCard.create(false)
+}
+
+var strikeCard = cardDefinition{
+	id:      "strike",
+	base:    CardStats{Cost: 1, Damage: 6},
+	upgrade: &CardStats{Damage: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.strike.name"),
+			Description: i18n.Msg("card.strike.description", s.Damage),
+			Rarity:      Basic,
+			Type:        AttackCard,
+			Color:       Colorless,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
 }
 
 // CreateDefendCard は基本的な防御カードを生成するのじゃ
 func CreateDefendCard() Card {
-	return Card{
-		Name:        "ディフェンド",
-		Description: "5ブロックを得る",
-		EnergyCost:  1,
-		Rarity:      Common,
-		Type:        SkillCard,
-		Effect: func(p *Player, e *Enemy) {
-			p.AddBlock(5)
-		},
-	}
+	return defendCard.create(false)
+}
+
+var defendCard = cardDefinition{
+	id:      "defend",
+	base:    CardStats{Cost: 1, Block: 5},
+	upgrade: &CardStats{Block: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.defend.name"),
+			Description: i18n.Msg("card.defend.description", s.Block),
+			Rarity:      Basic,
+			Type:        SkillCard,
+			Color:       Colorless,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+			},
+		}
+	},
 }
 
 // CreateBashCard は基本的なバッシュカードを生成するのじゃ
 func CreateBashCard() Card {
-	return Card{
-		Name:        "バッシュ",
-		Description: "8ダメージを与え、2脆弱を付与する",
-		EnergyCost:  2,
-		Rarity:      Common,
-		Type:        AttackCard,
-		Effect: func(p *Player, e *Enemy) {
-			e.ApplyDamage(8)
-			e.ApplyVulnerable(2)
-		},
-	}
+	return bashCard.create(false)
+}
+
+var bashCard = cardDefinition{
+	id:      "bash",
+	base:    CardStats{Cost: 2, Damage: 8, Magic: 2},
+	upgrade: &CardStats{Damage: 2, Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.bash.name"),
+			Description: i18n.Msg("card.bash.description", s.Damage, s.Magic),
+			Rarity:      Basic,
+			Type:        AttackCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+				e.ApplyVulnerable(s.Magic)
+			},
+		}
+	},
 }
 
 // CreatePommelStrikeCard はコモンの攻撃カードを生成するのじゃ
 func CreatePommelStrikeCard() Card {
-	return Card{
-		Name:        "ポンメルストライク",
-		Description: "9ダメージを与え、カードを1枚引く",
-		EnergyCost:  1,
-		Rarity:      Common,
-		Type:        AttackCard,
-		Effect: func(p *Player, e *Enemy) {
-			e.ApplyDamage(9)
-			p.DrawCount += 1 // カード引き処理はCombatServiceで実行
-		},
-	}
+	return pommelStrikeCard.create(false)
+}
+
+var pommelStrikeCard = cardDefinition{
+	id:      "pommel_strike",
+	base:    CardStats{Cost: 1, Damage: 9, Magic: 1},
+	upgrade: &CardStats{Damage: 1, Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.pommel_strike.name"),
+			Description: i18n.Msg("card.pommel_strike.description", s.Damage, i18n.Plural("card.text.draw", s.Magic)),
+			Rarity:      Common,
+			Type:        AttackCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+				p.DrawCount += s.Magic // カード引き処理はCombatServiceで実行
+			},
+		}
+	},
 }
 
 // CreateShockwaveCard はアンコモンの攻撃カードを生成するのじゃ
 func CreateShockwaveCard() Card {
-	return Card{
-		Name:        "衝撃波",
-		Description: "全ての敵に3脆弱と3弱体化を付与する",
-		EnergyCost:  2,
-		Rarity:      Uncommon,
-		Type:        SkillCard,
-		Effect: func(p *Player, e *Enemy) {
-			e.ApplyVulnerable(3)
-			e.ApplyWeak(3)
-		},
-	}
+	return shockwaveCard.create(false)
+}
+
+var shockwaveCard = cardDefinition{
+	id:      "shockwave",
+	base:    CardStats{Cost: 2, Magic: 3},
+	upgrade: &CardStats{Magic: 2},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.shockwave.name"),
+			Description: i18n.Msg("card.shockwave.description", s.Magic, s.Magic),
+			Rarity:      Uncommon,
+			Type:        SkillCard,
+			Color:       Red,
+			Exhaust:     true,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyVulnerable(s.Magic)
+				e.ApplyWeak(s.Magic)
+			},
+		}
+	},
 }
 
 // CreateInflameCard はアンコモンのパワーカードを生成するのじゃ
 func CreateInflameCard() Card {
-	return Card{
-		Name:        "発火",
-		Description: "筋力を2得る",
-		EnergyCost:  1,
-		Rarity:      Uncommon,
-		Type:        PowerCard,
-		Effect: func(p *Player, e *Enemy) {
-			p.AddStrength(2)
-		},
-	}
+	return inflameCard.create(false)
+}
+
+var inflameCard = cardDefinition{
+	id:      "inflame",
+	base:    CardStats{Cost: 1, Magic: 2},
+	upgrade: &CardStats{Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.inflame.name"),
+			Description: i18n.Msg("card.inflame.description", s.Magic),
+			Rarity:      Uncommon,
+			Type:        PowerCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddStrength(s.Magic)
+			},
+		}
+	},
 }
 
 // CreateLimitBreakCard はレアのスキルカードを生成するのじゃ
 func CreateLimitBreakCard() Card {
-	return Card{
-		Name:        "限界突破",
-		Description: "筋力を2倍にする",
-		EnergyCost:  3,
-		Rarity:      Rare,
-		Type:        SkillCard,
-		Effect: func(p *Player, e *Enemy) {
-			p.SetStrength(p.Strength * 2)
-		},
-	}
+	return limitBreakCard.create(false)
+}
+
+// limitBreakCard はアップグレードで数値ではなく廃棄が外れるのじゃ
+var limitBreakCard = cardDefinition{
+	id:      "limit_break",
+	base:    CardStats{Cost: 3},
+	upgrade: &CardStats{},
+	build: func(s CardStats, upgraded bool) Card {
+		description := i18n.Msg("card.limit_break.description")
+		if upgraded {
+			description = i18n.Msg("card.limit_break.description_upgraded")
+		}
+		return Card{
+			Name:        i18n.Msg("card.limit_break.name"),
+			Description: description,
+			Rarity:      Rare,
+			Type:        SkillCard,
+			Color:       Red,
+			Exhaust:     !upgraded,
+			Effect: func(p *Player, e *Enemy) {
+				p.SetStrength(p.Strength * 2)
+			},
+		}
+	},
 }
 
 // CreateDemonFormCard はレアのパワーカードを生成するのじゃ
 func CreateDemonFormCard() Card {
-	return Card{
-		Name:        "悪魔化",
-		Description: "ターン開始時に筋力を3得る",
-		EnergyCost:  3,
-		Rarity:      Rare,
-		Type:        PowerCard,
-		Effect: func(p *Player, e *Enemy) {
-			p.AddPower(&Power{
-				Name:        "悪魔化",
-				Description: "ターン開始時に筋力を3得る",
-				OnTurnStart: func(p *Player, e *Enemy) {
-					p.AddStrength(3)
-				},
-			})
-		},
-	}
+	return demonFormCard.create(false)
+}
+
+var demonFormCard = cardDefinition{
+	id:      "demon_form",
+	base:    CardStats{Cost: 3, Magic: 3},
+	upgrade: &CardStats{Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.demon_form.name"),
+			Description: i18n.Msg("card.demon_form.description", s.Magic),
+			Rarity:      Rare,
+			Type:        PowerCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddPower(&Power{
+					Name:        i18n.Msg("card.demon_form.name"),
+					Description: i18n.Msg("card.demon_form.description", s.Magic),
+					Duration:    -1,
+					OnTurnStart: func(p *Player, e *Enemy) {
+						p.AddStrength(s.Magic)
+					},
+				})
+			},
+		}
+	},
+}
+
+// CreateTwinStrikeCard はコモンの攻撃カードを生成するのじゃ
+func CreateTwinStrikeCard() Card {
+	return twinStrikeCard.create(false)
+}
+
+var twinStrikeCard = cardDefinition{
+	id:      "twin_strike",
+	base:    CardStats{Cost: 1, Damage: 5},
+	upgrade: &CardStats{Damage: 2},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.twin_strike.name"),
+			Description: i18n.Msg("card.twin_strike.description", s.Damage),
+			Rarity:      Common,
+			Type:        AttackCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateIronWaveCard はコモンの攻撃カードを生成するのじゃ
+func CreateIronWaveCard() Card {
+	return ironWaveCard.create(false)
+}
+
+var ironWaveCard = cardDefinition{
+	id:      "iron_wave",
+	base:    CardStats{Cost: 1, Damage: 5, Block: 5},
+	upgrade: &CardStats{Damage: 2, Block: 2},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.iron_wave.name"),
+			Description: i18n.Msg("card.iron_wave.description", s.Block, s.Damage),
+			Rarity:      Common,
+			Type:        AttackCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateShrugItOffCard はコモンのスキルカードを生成するのじゃ
+func CreateShrugItOffCard() Card {
+	return shrugItOffCard.create(false)
+}
+
+var shrugItOffCard = cardDefinition{
+	id:      "shrug_it_off",
+	base:    CardStats{Cost: 1, Block: 8, Magic: 1},
+	upgrade: &CardStats{Block: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.shrug_it_off.name"),
+			Description: i18n.Msg("card.shrug_it_off.description", s.Block, i18n.Plural("card.text.draw", s.Magic)),
+			Rarity:      Common,
+			Type:        SkillCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+				p.DrawCount += s.Magic
+			},
+		}
+	},
+}
+
+// CreateBodySlamCard はコモンの攻撃カードを生成するのじゃ
+func CreateBodySlamCard() Card {
+	return bodySlamCard.create(false)
+}
+
+var bodySlamCard = cardDefinition{
+	id:      "body_slam",
+	base:    CardStats{Cost: 1},
+	upgrade: &CardStats{Cost: -1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.body_slam.name"),
+			Description: i18n.Msg("card.body_slam.description"),
+			Rarity:      Common,
+			Type:        AttackCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(p.Block, e))
+			},
+		}
+	},
+}
+
+// CreateMetallicizeCard はアンコモンのパワーカードを生成するのじゃ
+func CreateMetallicizeCard() Card {
+	return metallicizeCard.create(false)
+}
+
+var metallicizeCard = cardDefinition{
+	id:      "metallicize",
+	base:    CardStats{Cost: 1, Magic: 3},
+	upgrade: &CardStats{Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.metallicize.name"),
+			Description: i18n.Msg("card.metallicize.description", s.Magic),
+			Rarity:      Uncommon,
+			Type:        PowerCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddPower(&Power{
+					Name:        i18n.Msg("card.metallicize.name"),
+					Description: i18n.Msg("card.metallicize.description", s.Magic),
+					Duration:    -1,
+					OnTurnEnd: func(p *Player, e *Enemy) {
+						p.AddBlock(s.Magic)
+					},
+				})
+			},
+		}
+	},
+}
+
+// CreateEntrenchCard はアンコモンのスキルカードを生成するのじゃ
+func CreateEntrenchCard() Card {
+	return entrenchCard.create(false)
+}
+
+var entrenchCard = cardDefinition{
+	id:      "entrench",
+	base:    CardStats{Cost: 2},
+	upgrade: &CardStats{Cost: -1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.entrench.name"),
+			Description: i18n.Msg("card.entrench.description"),
+			Rarity:      Uncommon,
+			Type:        SkillCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.Block)
+			},
+		}
+	},
+}
+
+// CreateBludgeonCard はレアの攻撃カードを生成するのじゃ
+func CreateBludgeonCard() Card {
+	return bludgeonCard.create(false)
+}
+
+var bludgeonCard = cardDefinition{
+	id:      "bludgeon",
+	base:    CardStats{Cost: 3, Damage: 32},
+	upgrade: &CardStats{Damage: 10},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.bludgeon.name"),
+			Description: i18n.Msg("card.bludgeon.description", s.Damage),
+			Rarity:      Rare,
+			Type:        AttackCard,
+			Color:       Red,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateNeutralizeCard はサイレントの初期デッキの攻撃カードを生成するのじゃ
+func CreateNeutralizeCard() Card {
+	return neutralizeCard.create(false)
+}
+
+var neutralizeCard = cardDefinition{
+	id:      "neutralize",
+	base:    CardStats{Cost: 0, Damage: 3, Magic: 1},
+	upgrade: &CardStats{Damage: 1, Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.neutralize.name"),
+			Description: i18n.Msg("card.neutralize.description", s.Damage, s.Magic),
+			Rarity:      Basic,
+			Type:        AttackCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+				e.ApplyWeak(s.Magic)
+			},
+		}
+	},
+}
+
+// CreateSurvivorCard はサイレントの初期デッキのスキルカードを生成するのじゃ
+func CreateSurvivorCard() Card {
+	return survivorCard.create(false)
+}
+
+var survivorCard = cardDefinition{
+	id:      "survivor",
+	base:    CardStats{Cost: 1, Block: 8},
+	upgrade: &CardStats{Block: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.survivor.name"),
+			Description: i18n.Msg("card.survivor.description", s.Block),
+			Rarity:      Basic,
+			Type:        SkillCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+			},
+		}
+	},
+}
+
+// CreateQuickSlashCard はコモンの攻撃カードを生成するのじゃ
+func CreateQuickSlashCard() Card {
+	return quickSlashCard.create(false)
+}
+
+var quickSlashCard = cardDefinition{
+	id:      "quick_slash",
+	base:    CardStats{Cost: 1, Damage: 8, Magic: 1},
+	upgrade: &CardStats{Damage: 4},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.quick_slash.name"),
+			Description: i18n.Msg("card.quick_slash.description", s.Damage, i18n.Plural("card.text.draw", s.Magic)),
+			Rarity:      Common,
+			Type:        AttackCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+				p.DrawCount += s.Magic
+			},
+		}
+	},
+}
+
+// CreateDaggerSprayCard はコモンの攻撃カードを生成するのじゃ
+func CreateDaggerSprayCard() Card {
+	return daggerSprayCard.create(false)
+}
+
+var daggerSprayCard = cardDefinition{
+	id:      "dagger_spray",
+	base:    CardStats{Cost: 1, Damage: 4},
+	upgrade: &CardStats{Damage: 2},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.dagger_spray.name"),
+			Description: i18n.Msg("card.dagger_spray.description", s.Damage),
+			Rarity:      Common,
+			Type:        AttackCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateBackflipCard はコモンのスキルカードを生成するのじゃ
+func CreateBackflipCard() Card {
+	return backflipCard.create(false)
+}
+
+var backflipCard = cardDefinition{
+	id:      "backflip",
+	base:    CardStats{Cost: 1, Block: 5, Magic: 2},
+	upgrade: &CardStats{Block: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.backflip.name"),
+			Description: i18n.Msg("card.backflip.description", s.Block, i18n.Plural("card.text.draw", s.Magic)),
+			Rarity:      Common,
+			Type:        SkillCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+				p.DrawCount += s.Magic
+			},
+		}
+	},
+}
+
+// CreateLegSweepCard はアンコモンのスキルカードを生成するのじゃ
+func CreateLegSweepCard() Card {
+	return legSweepCard.create(false)
+}
+
+var legSweepCard = cardDefinition{
+	id:      "leg_sweep",
+	base:    CardStats{Cost: 2, Block: 11, Magic: 2},
+	upgrade: &CardStats{Block: 3, Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.leg_sweep.name"),
+			Description: i18n.Msg("card.leg_sweep.description", s.Magic, s.Block),
+			Rarity:      Uncommon,
+			Type:        SkillCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyWeak(s.Magic)
+				p.AddBlock(p.BlockGain(s.Block))
+			},
+		}
+	},
+}
+
+// CreateDashCard はアンコモンの攻撃カードを生成するのじゃ
+func CreateDashCard() Card {
+	return dashCard.create(false)
+}
+
+var dashCard = cardDefinition{
+	id:      "dash",
+	base:    CardStats{Cost: 2, Damage: 10, Block: 10},
+	upgrade: &CardStats{Damage: 3, Block: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.dash.name"),
+			Description: i18n.Msg("card.dash.description", s.Block, s.Damage),
+			Rarity:      Uncommon,
+			Type:        AttackCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateAdrenalineCard はレアのスキルカードを生成するのじゃ
+func CreateAdrenalineCard() Card {
+	return adrenalineCard.create(false)
+}
+
+// adrenalineCard のMagicは得るエナジーじゃ。引く枚数は2枚のまま変わらないのじゃ
+var adrenalineCard = cardDefinition{
+	id:      "adrenaline",
+	base:    CardStats{Cost: 0, Magic: 1},
+	upgrade: &CardStats{Magic: 1},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.adrenaline.name"),
+			Description: i18n.Msg("card.adrenaline.description", s.Magic, i18n.Plural("card.text.draw", 2)),
+			Rarity:      Rare,
+			Type:        SkillCard,
+			Color:       Green,
+			Exhaust:     true,
+			Effect: func(p *Player, e *Enemy) {
+				p.Energy += s.Magic
+				p.DrawCount += 2
+			},
+		}
+	},
+}
+
+// CreateDieDieDieCard はレアの攻撃カードを生成するのじゃ
+func CreateDieDieDieCard() Card {
+	return dieDieDieCard.create(false)
+}
+
+var dieDieDieCard = cardDefinition{
+	id:      "die_die_die",
+	base:    CardStats{Cost: 1, Damage: 13},
+	upgrade: &CardStats{Damage: 4},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.die_die_die.name"),
+			Description: i18n.Msg("card.die_die_die.description", s.Damage),
+			Rarity:      Rare,
+			Type:        AttackCard,
+			Color:       Green,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateSwiftStrikeCard は無色の攻撃カードを生成するのじゃ
+func CreateSwiftStrikeCard() Card {
+	return swiftStrikeCard.create(false)
+}
+
+var swiftStrikeCard = cardDefinition{
+	id:      "swift_strike",
+	base:    CardStats{Cost: 0, Damage: 7},
+	upgrade: &CardStats{Damage: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.swift_strike.name"),
+			Description: i18n.Msg("card.swift_strike.description", s.Damage),
+			Rarity:      Uncommon,
+			Type:        AttackCard,
+			Color:       Colorless,
+			Effect: func(p *Player, e *Enemy) {
+				e.ApplyDamage(p.AttackDamage(s.Damage, e))
+			},
+		}
+	},
+}
+
+// CreateGoodInstinctsCard は無色のスキルカードを生成するのじゃ
+func CreateGoodInstinctsCard() Card {
+	return goodInstinctsCard.create(false)
+}
+
+var goodInstinctsCard = cardDefinition{
+	id:      "good_instincts",
+	base:    CardStats{Cost: 0, Block: 6},
+	upgrade: &CardStats{Block: 3},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.good_instincts.name"),
+			Description: i18n.Msg("card.good_instincts.description", s.Block),
+			Rarity:      Uncommon,
+			Type:        SkillCard,
+			Color:       Colorless,
+			Effect: func(p *Player, e *Enemy) {
+				p.AddBlock(p.BlockGain(s.Block))
+			},
+		}
+	},
+}
+
+// CreateInjuryCard は使えない呪いカードを生成するのじゃ
+func CreateInjuryCard() Card {
+	return injuryCard.create(false)
+}
+
+// injuryCard は呪いなのでアップグレードできないのじゃ
+var injuryCard = cardDefinition{
+	id:   "injury",
+	base: CardStats{Cost: 0},
+	build: func(s CardStats, _ bool) Card {
+		return Card{
+			Name:        i18n.Msg("card.injury.name"),
+			Description: i18n.Msg("card.injury.description"),
+			Rarity:      Common,
+			Type:        CurseCard,
+			Color:       Colorless,
+			Unplayable:  true,
+		}
+	},
 }
 
 // CreateAttackCard は旧関数の互換性のためにストライクカードを返す
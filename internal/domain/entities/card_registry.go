@@ -0,0 +1,61 @@
+package entities
+
+// cardDefinitions は全てのカードの定義の一覧じゃ
+// 新しいカードを追加したらここにも登録するのじゃ
+var cardDefinitions = []cardDefinition{
+	strikeCard,
+	defendCard,
+	bashCard,
+	pommelStrikeCard,
+	twinStrikeCard,
+	ironWaveCard,
+	shrugItOffCard,
+	bodySlamCard,
+	shockwaveCard,
+	inflameCard,
+	metallicizeCard,
+	entrenchCard,
+	limitBreakCard,
+	demonFormCard,
+	bludgeonCard,
+	neutralizeCard,
+	survivorCard,
+	quickSlashCard,
+	daggerSprayCard,
+	backflipCard,
+	legSweepCard,
+	dashCard,
+	adrenalineCard,
+	dieDieDieCard,
+	swiftStrikeCard,
+	goodInstinctsCard,
+	injuryCard,
+}
+
+// AllCards は登録されている全てのカードを1枚ずつ返すのじゃ
+func AllCards() []Card {
+	cards := make([]Card, 0, len(cardDefinitions))
+	for _, definition := range cardDefinitions {
+		cards = append(cards, definition.create(false))
+	}
+	return cards
+}
+
+// CreateCardByID はIDからカードを生成するのじゃ
+func CreateCardByID(id string) (Card, bool) {
+	definition, ok := findCardDefinition(id)
+	if !ok {
+		return Card{}, false
+	}
+	return definition.create(false), true
+}
+
+// findCardDefinition はIDからカードの定義を探すのじゃ
+func findCardDefinition(id string) (cardDefinition, bool) {
+	for _, definition := range cardDefinitions {
+		if definition.id == id {
+			return definition, true
+		}
+	}
+	return cardDefinition{}, false
+}
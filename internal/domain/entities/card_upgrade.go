@@ -0,0 +1,17 @@
+package entities
+
+// Upgrade はカードをアップグレードしたものを返すのじゃ
+// アップグレード済みのカードや、アップグレードの無いカードはそのまま返し、falseを返すのじゃ
+func (c *Card) Upgrade() (Card, bool) {
+	if !c.CanUpgrade() {
+		return *c, false
+	}
+	definition, _ := findCardDefinition(c.ID)
+	return definition.create(true), true
+}
+
+// CanUpgrade はカードをアップグレードできるかを判定するのじゃ
+func (c *Card) CanUpgrade() bool {
+	definition, ok := findCardDefinition(c.ID)
+	return ok && definition.upgrade != nil && !c.Upgraded
+}
@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// Character は操作できるキャラクターの定義じゃ
+type Character struct {
+	ID           string
+	Name         i18n.Message
+	Description  i18n.Message
+	MaxHealth    int
+	Gold         int      // ラン開始時の所持金じゃ
+	StarterDeck  []string // 初期デッキのカードのIDじゃ
+	StarterRelic string   // 初期レリックのIDじゃ
+	Color        CardColor
+}
+
+// DefaultCharacterID は最初から使えるキャラクターのIDじゃ
+const DefaultCharacterID = "ironclad"
+
+// characters は全てのキャラクターの一覧じゃ
+var characters = []Character{
+	{
+		ID:           "ironclad",
+		Name:         i18n.Msg("character.ironclad.name"),
+		Description:  i18n.Msg("character.ironclad.description"),
+		MaxHealth:    80,
+		Gold:         99,
+		StarterDeck:  []string{"strike", "strike", "strike", "strike", "strike", "defend", "defend", "defend", "defend", "bash", "pommel_strike", "pommel_strike"},
+		StarterRelic: "burning_blood",
+		Color:        Red,
+	},
+	{
+		ID:           "silent",
+		Name:         i18n.Msg("character.silent.name"),
+		Description:  i18n.Msg("character.silent.description"),
+		MaxHealth:    70,
+		Gold:         99,
+		StarterDeck:  []string{"strike", "strike", "strike", "strike", "strike", "defend", "defend", "defend", "defend", "defend", "neutralize", "survivor"},
+		StarterRelic: "ring_of_the_snake",
+		Color:        Green,
+	},
+}
+
+// AllCharacters は全てのキャラクターを返すのじゃ
+func AllCharacters() []Character {
+	return characters
+}
+
+// GetCharacterByID はIDからキャラクターを求めるのじゃ
+func GetCharacterByID(id string) (Character, bool) {
+	for _, character := range characters {
+		if character.ID == id {
+			return character, true
+		}
+	}
+	return Character{}, false
+}
+
+// GetCharacterName はIDからキャラクターの表示名を返すのじゃ
+// 知らないIDなら、名前で記録した古い履歴かもしれないので、そのまま返すのじゃ
+func GetCharacterName(id string) string {
+	if character, ok := GetCharacterByID(id); ok {
+		return character.Name.String()
+	}
+	return id
+}
+
+// IsStarterRelic はいずれかのキャラクターの初期レリックかを判定するのじゃ
+func IsStarterRelic(id string) bool {
+	for _, character := range characters {
+		if character.StarterRelic == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CanUseCard はキャラクターがカードを報酬や店で手に入れられるかを判定するのじゃ
+func (c Character) CanUseCard(card Card) bool {
+	return card.Color == Colorless || card.Color == c.Color
+}
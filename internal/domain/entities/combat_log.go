@@ -0,0 +1,180 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// LogKind は戦闘ログの出来事の種類じゃ
+type LogKind int
+
+// 戦闘ログの出来事の種類の定義
+const (
+	LogCombat     LogKind = iota // 戦闘の始まりと終わりじゃ
+	LogTurn                      // ターンの区切りじゃ
+	LogCardPlayed                // カードを使ったのじゃ
+	LogDamage                    // ダメージを受けたのじゃ
+	LogBlock                     // ブロックを得たのじゃ
+	LogHeal                      // 体力を回復したのじゃ
+	LogStatus                    // 筋力や脆弱などの状態が変わったのじゃ
+	LogPower                     // パワーを得た、またはパワーが発動したのじゃ
+	LogEnemyMove                 // 敵が行動したのじゃ
+	LogDraw                      // カードを引いたのじゃ
+	LogShuffle                   // 捨て札をシャッフルして山札にしたのじゃ
+	LogPotion                    // ポーションを使ったのじゃ
+)
+
+// LogEntry は戦闘ログの1行分の出来事じゃ
+type LogEntry struct {
+	Floor int // 出来事が起きたフロアじゃ
+	Turn  int // 出来事が起きたターンじゃ。戦闘の前なら0じゃ
+	Kind  LogKind
+	Text  i18n.Message // 書き出すときや表示するときに今の言語の文字列にするのじゃ
+}
+
+// CombatLog はラン中の戦闘で起きた出来事を順に記録するのじゃ
+// ランの間は戦闘をまたいで残し、中断しても引き継いで、終わった後に書き出せるのじゃ
+// nilのままでも呼び出せて、そのときは何も記録しないのじゃ
+type CombatLog struct {
+	Entries []LogEntry
+	floor   int
+	turn    int
+}
+
+// NewCombatLog は空の戦闘ログを生成するのじゃ
+func NewCombatLog() *CombatLog {
+	return &CombatLog{Entries: []LogEntry{}}
+}
+
+// Add は出来事を1行記録するのじゃ
+// 文ではなくメッセージのキーと引数で記録し、後から言語を切り替えても読めるようにするのじゃ
+func (l *CombatLog) Add(kind LogKind, key string, args ...any) {
+	if l == nil {
+		return
+	}
+	l.Entries = append(l.Entries, LogEntry{
+		Floor: l.floor,
+		Turn:  l.turn,
+		Kind:  kind,
+		Text:  i18n.Msg(key, args...),
+	})
+}
+
+// Insert は出来事を指定された位置に差し込んで記録するのじゃ
+func (l *CombatLog) Insert(index int, kind LogKind, key string, args ...any) {
+	if l == nil {
+		return
+	}
+	l.Add(kind, key, args...)
+	entry := l.Entries[len(l.Entries)-1]
+	copy(l.Entries[index+1:], l.Entries[index:len(l.Entries)-1])
+	l.Entries[index] = entry
+}
+
+// Len は記録した出来事の数を返すのじゃ
+func (l *CombatLog) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.Entries)
+}
+
+// StartCombat は新しい戦闘の始まりを記録するのじゃ
+func (l *CombatLog) StartCombat(floor int, enemyName i18n.Message) {
+	if l == nil {
+		return
+	}
+	l.floor, l.turn = floor, 0
+	l.Add(LogCombat, "log.combat_start", floor, enemyName)
+}
+
+// StartTurn は新しいターンの始まりを記録するのじゃ
+func (l *CombatLog) StartTurn() {
+	if l == nil {
+		return
+	}
+	l.turn++
+	l.Add(LogTurn, "log.turn", l.turn)
+}
+
+// String は戦闘ログを書き出すための文字列にするのじゃ
+// 戦闘とターンの区切りは見出しにして、出来事は字下げするのじゃ
+func (l *CombatLog) String() string {
+	if l == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, entry := range l.Entries {
+		switch entry.Kind {
+		case LogCombat:
+			fmt.Fprintf(&b, "== %s ==\n", entry.Text)
+		case LogTurn:
+			fmt.Fprintf(&b, "-- %s --\n", entry.Text)
+		default:
+			fmt.Fprintf(&b, "  %s\n", entry.Text)
+		}
+	}
+	return b.String()
+}
+
+// LogVerbosity は戦闘ログの欄にどこまで細かく出来事を出すかじゃ
+// 記録と書き出しは常に全ての出来事を残し、表示するときだけ絞るのじゃ
+type LogVerbosity int
+
+// 戦闘ログの細かさの定義
+const (
+	LogVerbose LogVerbosity = iota // 全ての出来事を出すのじゃ
+	LogNormal                      // カードを引いたこととシャッフルを省くのじゃ
+	LogBrief                       // 区切りとカード、ダメージ、敵の行動だけを出すのじゃ
+	logVerbosityCount
+)
+
+// logVerbosityNames は設定で使う戦闘ログの細かさの名前じゃ
+var logVerbosityNames = map[LogVerbosity]string{
+	LogVerbose: "all",
+	LogNormal:  "normal",
+	LogBrief:   "brief",
+}
+
+// String は戦闘ログの細かさの名前を返すのじゃ
+func (v LogVerbosity) String() string {
+	return logVerbosityNames[v]
+}
+
+// Label は画面に表示する戦闘ログの細かさの名前を返すのじゃ
+func (v LogVerbosity) Label() i18n.Message {
+	return i18n.Msg("log_verbosity." + v.String())
+}
+
+// Next は次の細かさを返すのじゃ。stepが負なら前の細かさじゃ
+func (v LogVerbosity) Next(step int) LogVerbosity {
+	return LogVerbosity((int(v) + step + int(logVerbosityCount)) % int(logVerbosityCount))
+}
+
+// ParseLogVerbosity は名前から戦闘ログの細かさを求めるのじゃ
+func ParseLogVerbosity(name string) (LogVerbosity, bool) {
+	for verbosity, n := range logVerbosityNames {
+		if n == name {
+			return verbosity, true
+		}
+	}
+	return LogVerbose, false
+}
+
+// Shows はこの細かさで出来事を表示するかを判定するのじゃ
+func (v LogVerbosity) Shows(kind LogKind) bool {
+	switch v {
+	case LogNormal:
+		return kind != LogDraw && kind != LogShuffle
+	case LogBrief:
+		switch kind {
+		case LogCombat, LogTurn, LogCardPlayed, LogDamage, LogEnemyMove, LogPotion:
+			return true
+		}
+		return false
+	default:
+		return true
+	}
+}
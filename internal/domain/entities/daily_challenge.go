@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"time"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// DailyModifier はデイリーチャレンジでランに加わる変化を表す型じゃ
+type DailyModifier int
+
+// デイリーチャレンジの変化の定義
+const (
+	DailyRandomRare DailyModifier = iota
+	DailyDoubleElites
+	DailyNoRestSites
+	DailyExpensiveCards
+)
+
+// dailyModifierCount は1日に選ばれる変化の数じゃ
+const dailyModifierCount = 2
+
+// DailyDateFormat はデイリーチャレンジの日付の書式じゃ
+const DailyDateFormat = "2006-01-02"
+
+// DailyChallenge はその日のデイリーチャレンジを表す構造体じゃ
+type DailyChallenge struct {
+	Date      string
+	Seed      int64
+	Modifiers []DailyModifier
+}
+
+// NewDailyChallenge は指定された日のデイリーチャレンジを生成するのじゃ
+// 時差で結果が変わらないよう、日付はUTCで決めるのじゃ
+func NewDailyChallenge(day time.Time) DailyChallenge {
+	date := day.UTC().Format(DailyDateFormat)
+
+	hash := fnv.New64a()
+	hash.Write([]byte("cts-daily:" + date))
+	seed := int64(hash.Sum64() >> 1)
+
+	// 変化は乱数の種から選ぶので、同じ日なら誰でも同じになるのじゃ
+	all := []DailyModifier{DailyRandomRare, DailyDoubleElites, DailyNoRestSites, DailyExpensiveCards}
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(all), func(i, j int) { all[i], all[j] = all[j], all[i] })
+
+	return DailyChallenge{
+		Date:      date,
+		Seed:      seed,
+		Modifiers: all[:dailyModifierCount],
+	}
+}
+
+// ParseDailyChallenge は日付の文字列からその日のデイリーチャレンジを求めるのじゃ
+func ParseDailyChallenge(date string) (DailyChallenge, error) {
+	day, err := time.Parse(DailyDateFormat, date)
+	if err != nil {
+		return DailyChallenge{}, err
+	}
+	return NewDailyChallenge(day), nil
+}
+
+// Has はその変化が選ばれているかを判定するのじゃ
+func (d *DailyChallenge) Has(modifier DailyModifier) bool {
+	for _, m := range d.Modifiers {
+		if m == modifier {
+			return true
+		}
+	}
+	return false
+}
+
+// dailyModifierDescriptionKeys は変化ごとの説明のキーじゃ
+var dailyModifierDescriptionKeys = map[DailyModifier]string{
+	DailyRandomRare:     "daily.random_rare",
+	DailyDoubleElites:   "daily.double_elites",
+	DailyNoRestSites:    "daily.no_rest_sites",
+	DailyExpensiveCards: "daily.expensive_cards",
+}
+
+// GetDailyModifierDescription は変化の説明を返すのじゃ
+func GetDailyModifierDescription(modifier DailyModifier) i18n.Message {
+	if key, ok := dailyModifierDescriptionKeys[modifier]; ok {
+		return i18n.Msg(key)
+	}
+	return i18n.Msg("common.unknown")
+}
@@ -1,12 +1,38 @@
 package entities
 
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// Intent は敵が次に取る行動の種類じゃ
+type Intent int
+
+// 敵の行動の種類の定義
+const (
+	IntentAttack Intent = iota
+	IntentDefend
+	IntentBuff
+)
+
+// 敵の行動の種類の表示名のキーじゃ
+var intentKeys = map[Intent]string{
+	IntentAttack: "intent.attack",
+	IntentDefend: "intent.defend",
+	IntentBuff:   "intent.buff",
+}
+
+// Name は行動の種類の表示名を返すのじゃ
+func (i Intent) Name() i18n.Message {
+	return i18n.Msg(intentKeys[i])
+}
+
 // Enemy は敵の状態を保持する構造体じゃ
 type Enemy struct {
-	Name       string
+	Name       i18n.Message
 	Health     int
 	MaxHealth  int
 	Block      int
-	Intention  string
+	Intention  Intent
 	Damage     int
 	Strength   int
 	Vulnerable int
@@ -14,30 +40,31 @@ type Enemy struct {
 	NextAction func(*Enemy, *Player)   // 敵の次の行動を定義する関数じゃ
 	Patterns   []func(*Enemy, *Player) // 敵の行動パターンのリストじゃ
 	PatternIdx int                     // 現在の行動パターンのインデックスじゃ
+	Log        *CombatLog              // 戦闘で起きたことの記録先じゃ。nilなら記録しないのじゃ
 }
 
 // NewSlimeEnemy はスライム敵のインスタンスを生成するのじゃ
 func NewSlimeEnemy() *Enemy {
 	// 攻撃パターン
 	attackAction := func(e *Enemy, p *Player) {
-		e.Intention = "攻撃"
-		e.Damage = 5 + e.Strength
+		e.Intention = IntentAttack
+		e.Damage = e.AttackDamage(5, p)
 		p.ApplyDamage(e.Damage)
 	}
 
 	// 防御パターン
 	defendAction := func(e *Enemy, p *Player) {
-		e.Intention = "防御"
+		e.Intention = IntentDefend
 		e.Damage = 0
 		e.AddBlock(5)
 	}
 
 	enemy := &Enemy{
-		Name:       "スライム",
+		Name:       i18n.Msg("enemy.slime"),
 		Health:     20,
 		MaxHealth:  20,
 		Block:      0,
-		Intention:  "攻撃",
+		Intention:  IntentAttack,
 		Damage:     5,
 		Strength:   0,
 		Vulnerable: 0,
@@ -56,32 +83,32 @@ func NewSlimeEnemy() *Enemy {
 func NewJawWormEnemy() *Enemy {
 	// 通常攻撃パターン
 	attackAction := func(e *Enemy, p *Player) {
-		e.Intention = "攻撃"
-		e.Damage = 11 + e.Strength
+		e.Intention = IntentAttack
+		e.Damage = e.AttackDamage(11, p)
 		p.ApplyDamage(e.Damage)
 	}
 
 	// 防御パターン
 	defendAction := func(e *Enemy, p *Player) {
-		e.Intention = "防御"
+		e.Intention = IntentDefend
 		e.Damage = 0
 		e.AddBlock(6)
 	}
 
 	// 強化パターン
 	buffAction := func(e *Enemy, p *Player) {
-		e.Intention = "強化"
+		e.Intention = IntentBuff
 		e.Damage = 0
-		e.Strength += 3
+		e.AddStrength(3)
 		e.AddBlock(6)
 	}
 
 	enemy := &Enemy{
-		Name:       "アゴムシ",
+		Name:       i18n.Msg("enemy.jaw_worm"),
 		Health:     40,
 		MaxHealth:  40,
 		Block:      0,
-		Intention:  "強化",
+		Intention:  IntentBuff,
 		Damage:     0,
 		Strength:   0,
 		Vulnerable: 0,
@@ -98,18 +125,23 @@ func NewJawWormEnemy() *Enemy {
 
 // ApplyDamage は敵にダメージを与えるのじゃ
 func (e *Enemy) ApplyDamage(damage int) {
-	if e.Block >= damage {
-		e.Block -= damage
-	} else {
-		dmgAfterBlock := damage - e.Block
-		e.Block = 0
-		e.Health -= dmgAfterBlock
-	}
+	blocked := min(e.Block, damage)
+	e.Block -= blocked
+	e.Health -= damage - blocked
+	logDamage(e.Log, e.Name, damage, blocked)
+}
+
+// AttackDamage は敵のアタックがプレイヤーに与えるダメージを求めるのじゃ
+// 筋力を足し、弱体化なら25%減らし、プレイヤーが脆弱なら50%増やすのじゃ
+func (e *Enemy) AttackDamage(base int, target *Player) int {
+	vulnerable := target != nil && target.Vulnerable > 0
+	return modifyAttackDamage(base, e.Strength, e.Weak > 0, vulnerable)
 }
 
 // AddBlock は敵のブロック値を増加させるのじゃ
 func (e *Enemy) AddBlock(amount int) {
 	e.Block += amount
+	e.Log.Add(LogBlock, "log.block", e.Name, amount, e.Block)
 }
 
 // IsDefeated は敵が倒されたかどうかを判定するのじゃ
@@ -119,7 +151,10 @@ func (e *Enemy) IsDefeated() bool {
 
 // PerformAction は敵のアクションをプレイヤーに対して実行するのじゃ
 func (e *Enemy) PerformAction(player *Player) {
+	// 何をしたかは行動の後で分かるので、行動で起きたことより前に差し込むのじゃ
+	start := e.Log.Len()
 	e.NextAction(e, player)
+	e.Log.Insert(start, LogEnemyMove, "log.enemy_move", e.Name, e.Intention.Name())
 
 	// 次の行動パターンを選択するのじゃ
 	e.PatternIdx = (e.PatternIdx + 1) % len(e.Patterns)
@@ -129,14 +164,17 @@ func (e *Enemy) PerformAction(player *Player) {
 // ApplyVulnerable は脆弱を付与するのじゃ
 func (e *Enemy) ApplyVulnerable(amount int) {
 	e.Vulnerable += amount
+	e.Log.Add(LogStatus, "log.vulnerable", e.Name, amount, e.Vulnerable)
 }
 
 // ApplyWeak は弱体化を付与するのじゃ
 func (e *Enemy) ApplyWeak(amount int) {
 	e.Weak += amount
+	e.Log.Add(LogStatus, "log.weak", e.Name, amount, e.Weak)
 }
 
 // AddStrength は筋力を増加させるのじゃ
 func (e *Enemy) AddStrength(amount int) {
 	e.Strength += amount
+	e.Log.Add(LogStatus, "log.strength", e.Name, amount, e.Strength)
 }
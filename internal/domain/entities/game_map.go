@@ -10,8 +10,82 @@ type GameMap struct {
 	CurrentNode *MapNode
 }
 
+// NodeWeight はマップ生成時のノードタイプの出やすさじゃ
+type NodeWeight struct {
+	Type   NodeType
+	Weight int
+}
+
+// MapConfig はマップ生成の設定じゃ
+type MapConfig struct {
+	FloorCount    int
+	NodesPerFloor int
+	NodeWeights   []NodeWeight // 最初と最後のフロア以外のノードの出やすさじゃ
+}
+
+// DefaultMapConfig は標準のマップ生成の設定を返すのじゃ
+func DefaultMapConfig() MapConfig {
+	return MapConfig{
+		FloorCount:    15, // 15フロア
+		NodesPerFloor: 4,  // 各フロア4ノード
+		NodeWeights: []NodeWeight{
+			{Type: NodeEnemy, Weight: 60},
+			{Type: NodeElite, Weight: 10},
+			{Type: NodeRest, Weight: 15},
+			{Type: NodeShop, Weight: 10},
+			{Type: NodeEvent, Weight: 5},
+		},
+	}
+}
+
+// GetWeight は指定されたノードタイプの出やすさを返すのじゃ
+func (c *MapConfig) GetWeight(nodeType NodeType) int {
+	for _, w := range c.NodeWeights {
+		if w.Type == nodeType {
+			return w.Weight
+		}
+	}
+	return 0
+}
+
+// AddWeight は指定されたノードタイプの出やすさを増減させるのじゃ
+func (c *MapConfig) AddWeight(nodeType NodeType, amount int) {
+	for i := range c.NodeWeights {
+		if c.NodeWeights[i].Type == nodeType {
+			c.NodeWeights[i].Weight = max(0, c.NodeWeights[i].Weight+amount)
+			return
+		}
+	}
+	if amount > 0 {
+		c.NodeWeights = append(c.NodeWeights, NodeWeight{Type: nodeType, Weight: amount})
+	}
+}
+
+// pickNodeType は出やすさに従ってノードタイプを1つ選ぶのじゃ
+func (c *MapConfig) pickNodeType(rng *rand.Rand) NodeType {
+	total := 0
+	for _, w := range c.NodeWeights {
+		total += w.Weight
+	}
+	if total <= 0 {
+		return NodeEnemy
+	}
+
+	r := rng.Intn(total)
+	for _, w := range c.NodeWeights {
+		if r < w.Weight {
+			return w.Type
+		}
+		r -= w.Weight
+	}
+	return NodeEnemy
+}
+
 // NewGameMap はゲームマップの新しいインスタンスを生成するのじゃ
-func NewGameMap(floorCount int, nodesPerFloor int) *GameMap {
+// 同じ乱数の種からは同じマップが生成されるのじゃ
+func NewGameMap(rng *rand.Rand, config MapConfig) *GameMap {
+	floorCount := config.FloorCount
+	nodesPerFloor := config.NodesPerFloor
 	gameMap := &GameMap{
 		Nodes: make([][]*MapNode, floorCount),
 	}
@@ -29,19 +103,7 @@ func NewGameMap(floorCount int, nodesPerFloor int) *GameMap {
 				nodeType = NodeEnemy // 最初のフロアは必ず敵
 			} else {
 				// ランダムにノードタイプを設定するのじゃ
-				r := rand.Intn(100)
-				switch {
-				case r < 60:
-					nodeType = NodeEnemy
-				case r < 70:
-					nodeType = NodeElite
-				case r < 85:
-					nodeType = NodeRest
-				case r < 95:
-					nodeType = NodeShop
-				default:
-					nodeType = NodeEvent
-				}
+				nodeType = config.pickNodeType(rng)
 			}
 
 			// ノードを作成するのじゃ
@@ -55,16 +117,16 @@ func NewGameMap(floorCount int, nodesPerFloor int) *GameMap {
 			currentNode := gameMap.Nodes[floor][x]
 
 			// 次のフロアの接続可能なノードをランダムに2-3個選ぶのじゃ
-			connectCount := rand.Intn(2) + 2 // 2か3
+			connectCount := rng.Intn(2) + 2 // 2か3
 			for i := 0; i < connectCount; i++ {
-				targetX := rand.Intn(nodesPerFloor)
+				targetX := rng.Intn(nodesPerFloor)
 				currentNode.AddConnection(gameMap.Nodes[floor+1][targetX])
 			}
 		}
 	}
 
 	// スタート地点を設定するのじゃ
-	gameMap.CurrentNode = gameMap.Nodes[0][rand.Intn(nodesPerFloor)]
+	gameMap.CurrentNode = gameMap.Nodes[0][rng.Intn(nodesPerFloor)]
 	gameMap.CurrentNode.Visited = true
 
 	return gameMap
@@ -82,3 +144,48 @@ func (m *GameMap) MoveToNode(node *MapNode) bool {
 	}
 	return false
 }
+
+// VisitedColumns はフロアごとに通ったノードの列を返すのじゃ
+// 最初のフロアから現在のフロアまで並べるのじゃ
+func (m *GameMap) VisitedColumns() []int {
+	columns := []int{}
+	for floor := 0; floor <= m.CurrentNode.Position.Floor; floor++ {
+		for _, node := range m.Nodes[floor] {
+			if node.Visited {
+				columns = append(columns, node.Position.X)
+				break
+			}
+		}
+	}
+	return columns
+}
+
+// RestorePath は通ったノードの列からマップ上の位置を復元するのじゃ
+// つながっていない道筋なら何もせずにfalseを返すのじゃ
+func (m *GameMap) RestorePath(columns []int) bool {
+	if len(columns) == 0 || len(columns) > len(m.Nodes) {
+		return false
+	}
+	path := make([]*MapNode, 0, len(columns))
+	for floor, x := range columns {
+		if x < 0 || x >= len(m.Nodes[floor]) {
+			return false
+		}
+		node := m.Nodes[floor][x]
+		if floor > 0 && !path[floor-1].IsConnectedTo(node) {
+			return false
+		}
+		path = append(path, node)
+	}
+
+	for _, row := range m.Nodes {
+		for _, node := range row {
+			node.Visited = false
+		}
+	}
+	for _, node := range path {
+		node.Visited = true
+	}
+	m.CurrentNode = path[len(path)-1]
+	return true
+}
@@ -12,4 +12,5 @@ const (
 	StateShop
 	StateEvent
 	StateGameOver
+	StateCharacterSelect
 )
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// Keyword はカードなどの説明に出てくる用語とその意味じゃ
+type Keyword struct {
+	ID          string
+	Name        i18n.Message // 説明の文に出てくる表記じゃ
+	Description i18n.Message
+}
+
+// keywords は用語集に載っている全ての用語じゃ
+var keywords = []Keyword{
+	{ID: "strength", Name: i18n.Msg("keyword.strength.name"), Description: i18n.Msg("keyword.strength.description")},
+	{ID: "dexterity", Name: i18n.Msg("keyword.dexterity.name"), Description: i18n.Msg("keyword.dexterity.description")},
+	{ID: "vulnerable", Name: i18n.Msg("keyword.vulnerable.name"), Description: i18n.Msg("keyword.vulnerable.description")},
+	{ID: "weak", Name: i18n.Msg("keyword.weak.name"), Description: i18n.Msg("keyword.weak.description")},
+	{ID: "block", Name: i18n.Msg("keyword.block.name"), Description: i18n.Msg("keyword.block.description")},
+	{ID: "energy", Name: i18n.Msg("keyword.energy.name"), Description: i18n.Msg("keyword.energy.description")},
+	{ID: "exhaust", Name: i18n.Msg("keyword.exhaust.name"), Description: i18n.Msg("keyword.exhaust.description")},
+	{ID: "unplayable", Name: i18n.Msg("keyword.unplayable.name"), Description: i18n.Msg("keyword.unplayable.description")},
+	{ID: "power", Name: i18n.Msg("keyword.power.name"), Description: i18n.Msg("keyword.power.description")},
+	{ID: "curse", Name: i18n.Msg("keyword.curse.name"), Description: i18n.Msg("keyword.curse.description")},
+}
+
+// AllKeywords は用語集の全ての用語を返すのじゃ
+func AllKeywords() []Keyword {
+	return append([]Keyword{}, keywords...)
+}
+
+// FindKeywords は文に出てくる用語を、出てくる順に返すのじゃ
+// 文は今の言語で表示したもので、用語も今の言語の表記で探すのじゃ
+// 同じ用語が何度出てきても1つにまとめるのじゃ
+func FindKeywords(text string) []Keyword {
+	type found struct {
+		keyword Keyword
+		index   int
+	}
+	matches := []found{}
+	for _, keyword := range keywords {
+		if index := strings.Index(text, keyword.Name.String()); index >= 0 {
+			matches = append(matches, found{keyword: keyword, index: index})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].index < matches[b].index
+	})
+
+	result := make([]Keyword, 0, len(matches))
+	for _, match := range matches {
+		result = append(result, match.keyword)
+	}
+	return result
+}
+
+// GetKeywordByID はIDから用語を返すのじゃ
+func GetKeywordByID(id string) (Keyword, bool) {
+	for _, keyword := range keywords {
+		if keyword.ID == id {
+			return keyword, true
+		}
+	}
+	return Keyword{}, false
+}
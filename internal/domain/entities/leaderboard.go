@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+)
+
+// LeaderboardEntry はデイリーチャレンジのリーダーボードの1件じゃ
+type LeaderboardEntry struct {
+	Date      string // デイリーチャレンジの日付じゃ
+	Player    string
+	Character string // キャラクターのIDじゃ。表示するときに今の言語の名前にするのじゃ
+	Score     int
+	Floor     int
+	Result    RunResult
+	Timestamp time.Time
+}
+
+// SameAs は同じランの記録かどうかを判定するのじゃ
+// 読み込みの際に重複を取り除くのに使うのじゃ
+func (e *LeaderboardEntry) SameAs(other LeaderboardEntry) bool {
+	return e.Date == other.Date && e.Player == other.Player && e.Timestamp.Equal(other.Timestamp)
+}
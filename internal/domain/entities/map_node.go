@@ -1,5 +1,9 @@
 package entities
 
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
 // NodeType はマップノードの種類を表す型じゃ
 type NodeType int
 
@@ -46,24 +50,31 @@ func (n *MapNode) AddConnection(node *MapNode) {
 	n.Connections = append(n.Connections, node)
 }
 
-// GetNodeTypeString はノードタイプを文字列で返すのじゃ
-func (n *MapNode) GetNodeTypeString() string {
-	switch n.Type {
-	case NodeEnemy:
-		return "敵"
-	case NodeElite:
-		return "エリート"
-	case NodeBoss:
-		return "ボス"
-	case NodeRest:
-		return "休憩"
-	case NodeShop:
-		return "店"
-	case NodeTreasure:
-		return "宝箱"
-	case NodeEvent:
-		return "イベント"
-	default:
-		return "不明"
+// IsConnectedTo は指定されたノードへつながっているかを判定するのじゃ
+func (n *MapNode) IsConnectedTo(node *MapNode) bool {
+	for _, connection := range n.Connections {
+		if connection == node {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeTypeKeys はノードの種類ごとの表示名のキーじゃ
+var nodeTypeKeys = map[NodeType]string{
+	NodeEnemy:    "node.enemy",
+	NodeElite:    "node.elite",
+	NodeBoss:     "node.boss",
+	NodeRest:     "node.rest",
+	NodeShop:     "node.shop",
+	NodeTreasure: "node.treasure",
+	NodeEvent:    "node.event",
+}
+
+// GetNodeTypeName はノードの種類の表示名を返すのじゃ
+func (n *MapNode) GetNodeTypeName() i18n.Message {
+	if key, ok := nodeTypeKeys[n.Type]; ok {
+		return i18n.Msg(key)
 	}
+	return i18n.Msg("common.unknown")
 }
@@ -1,36 +1,51 @@
 package entities
 
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
 // Player はプレイヤーの状態を保持する構造体じゃ
 type Player struct {
-	Health      int
-	MaxHealth   int
-	Gold        int
-	Block       int
-	Deck        []Card
-	Hand        []Card
-	DrawPile    []Card
-	DiscardPile []Card
-	Energy      int
-	MaxEnergy   int
-	Strength    int
-	Dexterity   int
-	Vulnerable  int
-	Weak        int
-	DrawCount   int // ターン終了時に追加でドローするカード枚数
-	Powers      []*Power
-}
-
-// NewPlayer はプレイヤーの新しいインスタンスを生成するのじゃ
-func NewPlayer() *Player {
+	Health       int
+	MaxHealth    int
+	Gold         int
+	Block        int
+	Deck         []Card
+	Hand         []Card
+	DrawPile     []Card
+	DiscardPile  []Card
+	ExhaustPile  []Card // 廃棄されて戦闘中は戻ってこないカードじゃ
+	Energy       int
+	MaxEnergy    int
+	Strength     int
+	Dexterity    int
+	Vulnerable   int
+	Weak         int
+	DrawCount    int // ターン終了時に追加でドローするカード枚数
+	Powers       []*Power
+	Relics       []Relic
+	Potions      []Potion
+	PotionSlots  int        // ポーションを持てる数じゃ
+	CostModifier int        // 全てのカードのコストに加える値じゃ
+	Log          *CombatLog // 戦闘で起きたことの記録先じゃ。nilなら記録しないのじゃ
+}
+
+// MaxHandSize は手札に持てるカードの上限じゃ
+const MaxHandSize = 10
+
+// NewPlayer はキャラクターの体力と所持金でプレイヤーの新しいインスタンスを生成するのじゃ
+// 初期デッキと初期レリックは呼び出し側で持たせるのじゃ
+func NewPlayer(character Character) *Player {
 	return &Player{
-		Health:      80,
-		MaxHealth:   80,
-		Gold:        0,
+		Health:      character.MaxHealth,
+		MaxHealth:   character.MaxHealth,
+		Gold:        character.Gold,
 		Block:       0,
 		Deck:        []Card{},
 		Hand:        []Card{},
 		DrawPile:    []Card{},
 		DiscardPile: []Card{},
+		ExhaustPile: []Card{},
 		Energy:      3,
 		MaxEnergy:   3,
 		Strength:    0,
@@ -39,23 +54,117 @@ func NewPlayer() *Player {
 		Weak:        0,
 		DrawCount:   0,
 		Powers:      []*Power{},
+		Relics:      []Relic{},
+		Potions:     []Potion{},
+		PotionSlots: 3,
 	}
 }
 
 // ApplyDamage はプレイヤーにダメージを与えるのじゃ
 func (p *Player) ApplyDamage(damage int) {
-	if p.Block >= damage {
-		p.Block -= damage
-	} else {
-		dmgAfterBlock := damage - p.Block
-		p.Block = 0
-		p.Health -= dmgAfterBlock
+	blocked := min(p.Block, damage)
+	p.Block -= blocked
+	p.Health -= damage - blocked
+	logDamage(p.Log, playerName, damage, blocked)
+}
+
+// playerName は戦闘ログでプレイヤーを指す名前じゃ
+var playerName = i18n.Msg("combat.player")
+
+// logDamage はダメージのうちブロックで防いだ分と体力を失った分を戦闘ログに1行で記録するのじゃ
+func logDamage(log *CombatLog, name i18n.Message, damage, blocked int) {
+	switch blocked {
+	case 0:
+		log.Add(LogDamage, "log.damage", name, damage, damage)
+	case damage:
+		log.Add(LogDamage, "log.damage_blocked", name, damage)
+	default:
+		log.Add(LogDamage, "log.damage_partly_blocked", name, damage, blocked, damage-blocked)
 	}
 }
 
 // AddBlock はプレイヤーのブロック値を増加させるのじゃ
 func (p *Player) AddBlock(amount int) {
 	p.Block += amount
+	p.Log.Add(LogBlock, "log.block", playerName, amount, p.Block)
+}
+
+// AttackDamage はプレイヤーのアタックが敵に与えるダメージを求めるのじゃ
+// 筋力を足し、弱体化なら25%減らし、敵が脆弱なら50%増やすのじゃ
+func (p *Player) AttackDamage(base int, target *Enemy) int {
+	vulnerable := target != nil && target.Vulnerable > 0
+	return modifyAttackDamage(base, p.Strength, p.Weak > 0, vulnerable)
+}
+
+// BlockGain はカードで得るブロック値を求めるのじゃ
+// 敏捷性の分だけ増減し、0より小さくはならないのじゃ
+func (p *Player) BlockGain(base int) int {
+	return max(0, base+p.Dexterity)
+}
+
+// modifyAttackDamage は筋力、弱体化、脆弱を加えたアタックのダメージを求めるのじゃ
+// プレイヤーと敵のどちらのアタックにも同じ計算を使うのじゃ
+func modifyAttackDamage(base, strength int, weak, vulnerable bool) int {
+	damage := base + strength
+	if weak {
+		damage = damage * 3 / 4
+	}
+	if vulnerable {
+		damage = damage * 3 / 2
+	}
+	return max(0, damage)
+}
+
+// GetCardCost はコストの補正を加えたカードの使用コストを返すのじゃ
+func (p *Player) GetCardCost(card Card) int {
+	return max(0, card.EnergyCost+p.CostModifier)
+}
+
+// Heal は最大体力を超えない範囲で体力を回復するのじゃ
+func (p *Player) Heal(amount int) {
+	healed := min(p.MaxHealth, p.Health+amount) - p.Health
+	p.Health += healed
+	if healed > 0 {
+		p.Log.Add(LogHeal, "log.heal", playerName, healed)
+	}
+}
+
+// AddRelic はレリックを手に入れるのじゃ
+func (p *Player) AddRelic(relic Relic) {
+	p.Relics = append(p.Relics, relic)
+	if relic.OnObtain != nil {
+		relic.OnObtain(p)
+	}
+}
+
+// HasRelic は指定されたレリックを持っているかを判定するのじゃ
+func (p *Player) HasRelic(id string) bool {
+	for _, relic := range p.Relics {
+		if relic.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ExecuteCombatStartRelics は戦闘開始時のレリック効果を実行するのじゃ
+func (p *Player) ExecuteCombatStartRelics(enemy *Enemy) {
+	for _, relic := range p.Relics {
+		if relic.OnCombatStart != nil {
+			p.Log.Add(LogPower, "log.relic_triggered", relic.Name)
+			relic.OnCombatStart(p, enemy)
+		}
+	}
+}
+
+// ExecuteCombatEndRelics は戦闘終了時のレリック効果を実行するのじゃ
+func (p *Player) ExecuteCombatEndRelics(enemy *Enemy) {
+	for _, relic := range p.Relics {
+		if relic.OnCombatEnd != nil {
+			p.Log.Add(LogPower, "log.relic_triggered", relic.Name)
+			relic.OnCombatEnd(p, enemy)
+		}
+	}
 }
 
 // ResetEnergy はプレイヤーのエナジーを最大値に戻すのじゃ
@@ -71,37 +180,44 @@ func (p *Player) IsDefeated() bool {
 // AddStrength は筋力を増減させるのじゃ
 func (p *Player) AddStrength(amount int) {
 	p.Strength += amount
+	p.Log.Add(LogStatus, "log.strength", playerName, amount, p.Strength)
 }
 
 // SetStrength は筋力を設定するのじゃ
 func (p *Player) SetStrength(amount int) {
 	p.Strength = amount
+	p.Log.Add(LogStatus, "log.strength_set", playerName, p.Strength)
 }
 
 // AddDexterity は敏捷性を増減させるのじゃ
 func (p *Player) AddDexterity(amount int) {
 	p.Dexterity += amount
+	p.Log.Add(LogStatus, "log.dexterity", playerName, amount, p.Dexterity)
 }
 
 // ApplyVulnerable は脆弱を付与するのじゃ
 func (p *Player) ApplyVulnerable(amount int) {
 	p.Vulnerable += amount
+	p.Log.Add(LogStatus, "log.vulnerable", playerName, amount, p.Vulnerable)
 }
 
 // ApplyWeak は弱体化を付与するのじゃ
 func (p *Player) ApplyWeak(amount int) {
 	p.Weak += amount
+	p.Log.Add(LogStatus, "log.weak", playerName, amount, p.Weak)
 }
 
 // AddPower はパワーを追加するのじゃ
 func (p *Player) AddPower(power *Power) {
 	p.Powers = append(p.Powers, power)
+	p.Log.Add(LogPower, "log.power_gained", power.Name)
 }
 
 // ExecuteStartTurnPowers はターン開始時のパワー効果を実行するのじゃ
 func (p *Player) ExecuteStartTurnPowers(enemy *Enemy) {
 	for _, power := range p.Powers {
 		if power.OnTurnStart != nil {
+			p.Log.Add(LogPower, "log.power_triggered", power.Name)
 			power.OnTurnStart(p, enemy)
 		}
 
@@ -125,7 +241,26 @@ func (p *Player) ExecuteStartTurnPowers(enemy *Enemy) {
 func (p *Player) ExecuteEndTurnPowers(enemy *Enemy) {
 	for _, power := range p.Powers {
 		if power.OnTurnEnd != nil {
+			p.Log.Add(LogPower, "log.power_triggered", power.Name)
 			power.OnTurnEnd(p, enemy)
 		}
 	}
 }
+
+// AddPotion はポーションを追加するのじゃ
+// 所持枠が埋まっていれば追加できないのじゃ
+func (p *Player) AddPotion(potion Potion) bool {
+	if len(p.Potions) >= p.PotionSlots {
+		return false
+	}
+	p.Potions = append(p.Potions, potion)
+	return true
+}
+
+// RemovePotion は指定された位置のポーションを取り除くのじゃ
+func (p *Player) RemovePotion(index int) {
+	if index < 0 || index >= len(p.Potions) {
+		return
+	}
+	p.Potions = append(p.Potions[:index], p.Potions[index+1:]...)
+}
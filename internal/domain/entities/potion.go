@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// PotionEffect はポーションの効果を表す関数型じゃ
+type PotionEffect func(*Player, *Enemy)
+
+// Potion は戦闘中に使える使い切りのアイテムじゃ
+type Potion struct {
+	ID          string
+	Name        i18n.Message
+	Description i18n.Message
+	Effect      PotionEffect
+}
+
+// CreateFirePotion は敵にダメージを与えるポーションを生成するのじゃ
+func CreateFirePotion() Potion {
+	return Potion{
+		ID:          "fire_potion",
+		Name:        i18n.Msg("potion.fire_potion.name"),
+		Description: i18n.Msg("potion.fire_potion.description", 20),
+		Effect: func(p *Player, e *Enemy) {
+			e.ApplyDamage(20)
+		},
+	}
+}
+
+// CreateBlockPotion はブロックを得るポーションを生成するのじゃ
+func CreateBlockPotion() Potion {
+	return Potion{
+		ID:          "block_potion",
+		Name:        i18n.Msg("potion.block_potion.name"),
+		Description: i18n.Msg("potion.block_potion.description", 12),
+		Effect: func(p *Player, e *Enemy) {
+			p.AddBlock(12)
+		},
+	}
+}
+
+// CreateStrengthPotion は筋力を得るポーションを生成するのじゃ
+func CreateStrengthPotion() Potion {
+	return Potion{
+		ID:          "strength_potion",
+		Name:        i18n.Msg("potion.strength_potion.name"),
+		Description: i18n.Msg("potion.strength_potion.description", 2),
+		Effect: func(p *Player, e *Enemy) {
+			p.AddStrength(2)
+		},
+	}
+}
+
+// potionFactories は全てのポーションの生成関数の一覧じゃ
+var potionFactories = []func() Potion{
+	CreateFirePotion,
+	CreateBlockPotion,
+	CreateStrengthPotion,
+}
+
+// AllPotions は登録されている全てのポーションを返すのじゃ
+func AllPotions() []Potion {
+	potions := make([]Potion, 0, len(potionFactories))
+	for _, create := range potionFactories {
+		potions = append(potions, create())
+	}
+	return potions
+}
+
+// CreatePotionByID はIDからポーションを生成するのじゃ
+func CreatePotionByID(id string) (Potion, bool) {
+	for _, create := range potionFactories {
+		if potion := create(); potion.ID == id {
+			return potion, true
+		}
+	}
+	return Potion{}, false
+}
@@ -1,12 +1,16 @@
 package entities
 
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
 // PowerEffect はパワーの効果を表す関数型じゃ
 type PowerEffect func(*Player, *Enemy)
 
 // Power はゲーム中のパワー効果を定義するのじゃ
 type Power struct {
-	Name          string
-	Description   string
+	Name          i18n.Message
+	Description   i18n.Message
 	Duration      int // -1は永続的なパワーを意味するのじゃ
 	OnTurnStart   PowerEffect
 	OnTurnEnd     PowerEffect
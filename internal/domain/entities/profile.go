@@ -0,0 +1,80 @@
+package entities
+
+// Profile はランをまたいで引き継がれるプレイヤーの進行状況じゃ
+type Profile struct {
+	MaxAscension int // 選択できる最も高いアセンションの段階じゃ
+	Experience   int // ランのスコアから貯まる経験値じゃ
+}
+
+// NewProfile は新しいプロフィールを生成するのじゃ
+func NewProfile() Profile {
+	return Profile{MaxAscension: 0, Experience: 0}
+}
+
+// UnlockNextAscension は指定された段階で勝利したときに次の段階を解放するのじゃ
+// 解放されたらtrueを返すのじゃ
+func (p *Profile) UnlockNextAscension(wonLevel int) bool {
+	if wonLevel < p.MaxAscension || wonLevel >= MaxAscensionLevel {
+		return false
+	}
+	p.MaxAscension = wonLevel + 1
+	return true
+}
+
+// AddExperience は経験値を加え、新しく解放された段階を返すのじゃ
+func (p *Profile) AddExperience(amount int) []UnlockTier {
+	if amount <= 0 {
+		return []UnlockTier{}
+	}
+
+	before := p.UnlockedTierCount()
+	p.Experience += amount
+	return unlockTiers[before:p.UnlockedTierCount()]
+}
+
+// UnlockedTierCount は解放済みの段階の数を返すのじゃ
+func (p *Profile) UnlockedTierCount() int {
+	count := 0
+	for _, tier := range unlockTiers {
+		if p.Experience >= tier.RequiredExperience {
+			count++
+		}
+	}
+	return count
+}
+
+// NextTier は次に解放される段階を返すのじゃ。全て解放済みならnilじゃ
+func (p *Profile) NextTier() *UnlockTier {
+	count := p.UnlockedTierCount()
+	if count >= len(unlockTiers) {
+		return nil
+	}
+	return &unlockTiers[count]
+}
+
+// IsCardUnlocked はカードが使えるようになっているかを判定するのじゃ
+func (p *Profile) IsCardUnlocked(id string) bool {
+	return p.isUnlocked(func(tier UnlockTier) []string { return tier.Cards }, id)
+}
+
+// IsRelicUnlocked はレリックが使えるようになっているかを判定するのじゃ
+func (p *Profile) IsRelicUnlocked(id string) bool {
+	return p.isUnlocked(func(tier UnlockTier) []string { return tier.Relics }, id)
+}
+
+// IsCharacterUnlocked はキャラクターが使えるようになっているかを判定するのじゃ
+func (p *Profile) IsCharacterUnlocked(id string) bool {
+	return p.isUnlocked(func(tier UnlockTier) []string { return tier.Characters }, id)
+}
+
+// isUnlocked は段階に載っているコンテンツが解放済みかを判定するのじゃ
+func (p *Profile) isUnlocked(contents func(UnlockTier) []string, id string) bool {
+	for _, tier := range unlockTiers {
+		for _, locked := range contents(tier) {
+			if locked == id {
+				return p.Experience >= tier.RequiredExperience
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package entities
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// RelicEffect はレリックの効果を表す関数型じゃ
+type RelicEffect func(*Player, *Enemy)
+
+// Relic はラン中ずっと効果を持つレリックを表すのじゃ
+type Relic struct {
+	ID            string
+	Name          i18n.Message
+	Description   i18n.Message
+	OnObtain      func(*Player) // 手に入れたときに一度だけ実行するのじゃ
+	OnCombatStart RelicEffect
+	OnCombatEnd   RelicEffect
+}
+
+// CreateBurningBloodRelic は戦闘後に回復するレリックを生成するのじゃ
+func CreateBurningBloodRelic() Relic {
+	return Relic{
+		ID:          "burning_blood",
+		Name:        i18n.Msg("relic.burning_blood.name"),
+		Description: i18n.Msg("relic.burning_blood.description", 6),
+		OnCombatEnd: func(p *Player, e *Enemy) {
+			p.Heal(6)
+		},
+	}
+}
+
+// CreateAnchorRelic は戦闘開始時にブロックを得るレリックを生成するのじゃ
+func CreateAnchorRelic() Relic {
+	return Relic{
+		ID:          "anchor",
+		Name:        i18n.Msg("relic.anchor.name"),
+		Description: i18n.Msg("relic.anchor.description", 10),
+		OnCombatStart: func(p *Player, e *Enemy) {
+			p.AddBlock(10)
+		},
+	}
+}
+
+// CreateVajraRelic は戦闘開始時に筋力を得るレリックを生成するのじゃ
+func CreateVajraRelic() Relic {
+	return Relic{
+		ID:          "vajra",
+		Name:        i18n.Msg("relic.vajra.name"),
+		Description: i18n.Msg("relic.vajra.description", 1),
+		OnCombatStart: func(p *Player, e *Enemy) {
+			p.AddStrength(1)
+		},
+	}
+}
+
+// CreateBloodVialRelic は戦闘開始時に回復するレリックを生成するのじゃ
+func CreateBloodVialRelic() Relic {
+	return Relic{
+		ID:          "blood_vial",
+		Name:        i18n.Msg("relic.blood_vial.name"),
+		Description: i18n.Msg("relic.blood_vial.description", 2),
+		OnCombatStart: func(p *Player, e *Enemy) {
+			p.Heal(2)
+		},
+	}
+}
+
+// CreateLanternRelic は最初のターンにエナジーを得るレリックを生成するのじゃ
+func CreateLanternRelic() Relic {
+	return Relic{
+		ID:          "lantern",
+		Name:        i18n.Msg("relic.lantern.name"),
+		Description: i18n.Msg("relic.lantern.description", 1),
+		OnCombatStart: func(p *Player, e *Enemy) {
+			p.Energy++
+		},
+	}
+}
+
+// CreatePotionBeltRelic はポーションの所持枠を増やすレリックを生成するのじゃ
+func CreatePotionBeltRelic() Relic {
+	return Relic{
+		ID:          "potion_belt",
+		Name:        i18n.Msg("relic.potion_belt.name"),
+		Description: i18n.Msg("relic.potion_belt.description", 2),
+		OnObtain: func(p *Player) {
+			p.PotionSlots += 2
+		},
+	}
+}
+
+// CreateRingOfTheSnakeRelic は戦闘開始時に追加でカードを引くレリックを生成するのじゃ
+func CreateRingOfTheSnakeRelic() Relic {
+	return Relic{
+		ID:          "ring_of_the_snake",
+		Name:        i18n.Msg("relic.ring_of_the_snake.name"),
+		Description: i18n.Msg("relic.ring_of_the_snake.description", 2),
+		OnCombatStart: func(p *Player, e *Enemy) {
+			p.DrawCount += 2
+		},
+	}
+}
+
+// relicFactories は全てのレリックの生成関数の一覧じゃ
+var relicFactories = []func() Relic{
+	CreateBurningBloodRelic,
+	CreateAnchorRelic,
+	CreateVajraRelic,
+	CreateBloodVialRelic,
+	CreateLanternRelic,
+	CreatePotionBeltRelic,
+	CreateRingOfTheSnakeRelic,
+}
+
+// AllRelics は登録されている全てのレリックを返すのじゃ
+func AllRelics() []Relic {
+	relics := make([]Relic, 0, len(relicFactories))
+	for _, create := range relicFactories {
+		relics = append(relics, create())
+	}
+	return relics
+}
+
+// CreateRelicByID はIDからレリックを生成するのじゃ
+func CreateRelicByID(id string) (Relic, bool) {
+	for _, create := range relicFactories {
+		if relic := create(); relic.ID == id {
+			return relic, true
+		}
+	}
+	return Relic{}, false
+}
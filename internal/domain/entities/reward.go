@@ -0,0 +1,23 @@
+package entities
+
+// RewardKind は戦闘の後に受け取れる報酬の種類じゃ
+type RewardKind int
+
+// 報酬の種類の定義
+const (
+	RewardGold   RewardKind = iota // ゴールドじゃ
+	RewardPotion                   // ポーションじゃ
+	RewardRelic                    // レリックじゃ
+	RewardCards                    // カード報酬じゃ。並んだカードから1枚を選んでデッキに加えるのじゃ
+)
+
+// Reward は戦闘の後に受け取れる報酬の1つじゃ
+// 報酬ごとに受け取るか捨てるかを選べるのじゃ
+// 種類に応じて、ゴールドの量、ポーション、レリック、選べるカードのどれかを持つのじゃ
+type Reward struct {
+	Kind   RewardKind
+	Gold   int
+	Potion Potion
+	Relic  Relic
+	Cards  []Card
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// RunResult はランの結果を表す型じゃ
+type RunResult int
+
+// ランの結果の定義
+const (
+	ResultVictory RunResult = iota
+	ResultDefeat
+	ResultAbandoned
+)
+
+// RunRecord は終了したランの記録を表す構造体じゃ
+type RunRecord struct {
+	ID         int // 履歴内での通し番号じゃ
+	Seed       int64
+	Character  string // キャラクターのIDじゃ。表示するときに今の言語の名前にするのじゃ
+	Ascension  int
+	Daily      string // デイリーチャレンジの日付じゃ。通常のランでは空じゃ
+	Floor      int
+	Result     RunResult
+	KilledBy   string // 敗北した場合の相手の敵の名前じゃ
+	Deck       []string
+	Relics     []string
+	Gold       int
+	Score      int
+	ScoreItems []ScoreItem
+	Duration   time.Duration
+	Timestamp  time.Time
+}
+
+// runResultKeys はランの結果ごとの表示名のキーじゃ
+var runResultKeys = map[RunResult]string{
+	ResultVictory:   "result.victory",
+	ResultDefeat:    "result.defeat",
+	ResultAbandoned: "result.abandoned",
+}
+
+// GetResultName はランの結果の表示名を返すのじゃ
+func (r *RunRecord) GetResultName() i18n.Message {
+	return GetRunResultName(r.Result)
+}
+
+// GetRunResultName はランの結果の表示名を返すのじゃ
+func GetRunResultName(result RunResult) i18n.Message {
+	if key, ok := runResultKeys[result]; ok {
+		return i18n.Msg(key)
+	}
+	return i18n.Msg("common.unknown")
+}
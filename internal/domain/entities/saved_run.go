@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+// SavedRun は中断したランを再開するために保存しておく内容じゃ
+// 乱数はフロアごとに種から作り直すので、位置とプレイヤーの状態だけ残せば同じ展開を再現できるのじゃ
+type SavedRun struct {
+	Seed         int64
+	Character    string // キャラクターのIDじゃ
+	Ascension    int
+	DailyDate    string // デイリーチャレンジでなければ空じゃ
+	PlayerName   string
+	Path         []int // フロアごとに通ったノードの列じゃ
+	InRoom       bool  // trueなら現在のノードに入った直後から再開するのじゃ。戦闘は始めからやり直すのじゃ
+	Health       int
+	MaxHealth    int
+	Gold         int
+	PotionSlots  int
+	CostModifier int
+	Deck         []string // カードのIDじゃ
+	Relics       []string // レリックのIDじゃ
+	Potions      []string // ポーションのIDじゃ
+	Stats        RunStats
+	Elapsed      time.Duration   // 中断するまでに遊んだ時間じゃ
+	Rewards      []SavedReward   // 受け取っていない報酬じゃ。あれば報酬の画面から再開するのじゃ
+	Shop         []SavedShopItem // 店の品ぞろえじゃ。あれば店の中から再開するのじゃ
+	Log          []LogEntry      // ここまでの戦闘ログじゃ。再開しても続きから記録するのじゃ
+}
+
+// SavedReward は受け取っていない報酬1つを保存しておく内容じゃ
+type SavedReward struct {
+	Kind   RewardKind
+	Gold   int
+	Potion string   // ポーションのIDじゃ
+	Relic  string   // レリックのIDじゃ
+	Cards  []string // カードのIDじゃ
+}
+
+// SavedShopItem は店の商品1つを保存しておく内容じゃ
+type SavedShopItem struct {
+	Kind  ShopItemKind
+	ID    string // 商品のカード、レリック、ポーションのIDじゃ
+	Price int
+	Sold  bool
+}
@@ -0,0 +1,29 @@
+package entities
+
+// RunStats はラン中の戦績を集計する構造体じゃ
+type RunStats struct {
+	FloorsClimbed int
+	EnemiesKilled int
+	ElitesKilled  int
+	BossesKilled  int
+	PerfectFights int // ダメージを受けずに勝った戦闘の数じゃ
+}
+
+// ScoreItem はスコアの内訳の1項目じゃ
+type ScoreItem struct {
+	Name   string
+	Detail string
+	Points int
+}
+
+// Score はランのスコアとその内訳じゃ
+type Score struct {
+	Items []ScoreItem
+	Total int
+}
+
+// AddItem は内訳に項目を追加して合計を更新するのじゃ
+func (s *Score) AddItem(name, detail string, points int) {
+	s.Items = append(s.Items, ScoreItem{Name: name, Detail: detail, Points: points})
+	s.Total += points
+}
@@ -0,0 +1,26 @@
+package entities
+
+// Settings はランをまたいで保存する遊び方の設定じゃ
+// 言語やテーマなどの名前は、空ならその場の環境に合わせて決めるのじゃ
+type Settings struct {
+	Language       string       // 表示する言語の名前じゃ。空ならLANGに従うのじゃ
+	Theme          string       // 配色のテーマの名前じゃ。空ならNO_COLORに従うのじゃ
+	Animation      string       // 戦闘のアニメーションの速さの名前じゃ
+	Keymap         string       // キーマップの名前じゃ。空ならキーマップのファイルに従うのじゃ
+	ConfirmEndTurn bool         // エナジーと使えるカードが残っているときにターン終了を確かめるかどうかじゃ
+	FastEnemyTurns bool         // 敵のターンの段階の間を短くするかどうかじゃ
+	DamagePreview  bool         // 選んでいるカードで与えるダメージを敵の下に出すかどうかじゃ
+	LogVerbosity   LogVerbosity // 戦闘ログの欄にどこまで細かく出すかじゃ
+	FrameRate      int          // 1秒あたりに描画する回数の上限じゃ
+}
+
+// NewSettings は既定の設定を生成するのじゃ
+func NewSettings() Settings {
+	return Settings{
+		Animation:      "normal",
+		ConfirmEndTurn: true,
+		DamagePreview:  true,
+		LogVerbosity:   LogVerbose,
+		FrameRate:      60,
+	}
+}
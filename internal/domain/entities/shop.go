@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// ShopItemKind は店の商品の種類を表す型じゃ
+type ShopItemKind int
+
+// 店の商品の種類の定義
+const (
+	ShopCard ShopItemKind = iota
+	ShopRelic
+	ShopPotion
+)
+
+// ShopItem は店の商品1つを表す構造体じゃ
+type ShopItem struct {
+	Kind   ShopItemKind
+	Card   Card
+	Relic  Relic
+	Potion Potion
+	Price  int
+	Sold   bool
+}
+
+// GetName は商品の名前を返すのじゃ
+func (item *ShopItem) GetName() i18n.Message {
+	switch item.Kind {
+	case ShopCard:
+		return item.Card.Name
+	case ShopRelic:
+		return item.Relic.Name
+	case ShopPotion:
+		return item.Potion.Name
+	default:
+		return i18n.Msg("common.unknown")
+	}
+}
+
+// GetDescription は商品の説明を返すのじゃ
+func (item *ShopItem) GetDescription() i18n.Message {
+	switch item.Kind {
+	case ShopCard:
+		return item.Card.Description
+	case ShopRelic:
+		return item.Relic.Description
+	case ShopPotion:
+		return item.Potion.Description
+	default:
+		return i18n.Message{}
+	}
+}
+
+// Shop は店に並んでいる商品の一覧じゃ
+type Shop struct {
+	Items []ShopItem
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// StatusKind は状態の数値が何を表すかの種類じゃ
+type StatusKind int
+
+// 状態の種類の定義
+const (
+	StatusStacks StatusKind = iota // 数値は重ねた数じゃ
+	StatusTurns                    // 数値は残りのターン数じゃ
+	StatusPower                    // パワーじゃ。数値は残りのターン数で、-1なら戦闘の終わりまで続くのじゃ
+)
+
+// Status は戦闘中の者にかかっている強化や弱体、パワーの1つじゃ
+type Status struct {
+	Name        i18n.Message
+	Amount      int
+	Kind        StatusKind
+	Debuff      bool // 弱体ならtrueじゃ
+	Description i18n.Message
+}
+
+// IsPermanent は戦闘の終わりまで続くパワーかどうかを返すのじゃ
+func (s Status) IsPermanent() bool {
+	return s.Kind == StatusPower && s.Amount < 0
+}
+
+// keywordStatus は用語集の用語から状態を作るのじゃ
+// 0の状態はかかっていないものとして作らないのじゃ
+func keywordStatus(statuses []Status, id string, amount int, kind StatusKind, debuff bool) []Status {
+	if amount == 0 {
+		return statuses
+	}
+	keyword, _ := GetKeywordByID(id)
+	return append(statuses, Status{
+		Name:        keyword.Name,
+		Amount:      amount,
+		Kind:        kind,
+		Debuff:      debuff || amount < 0,
+		Description: keyword.Description,
+	})
+}
+
+// Statuses はプレイヤーにかかっている状態とパワーを返すのじゃ
+func (p *Player) Statuses() []Status {
+	statuses := []Status{}
+	statuses = keywordStatus(statuses, "strength", p.Strength, StatusStacks, false)
+	statuses = keywordStatus(statuses, "dexterity", p.Dexterity, StatusStacks, false)
+	statuses = keywordStatus(statuses, "vulnerable", p.Vulnerable, StatusTurns, true)
+	statuses = keywordStatus(statuses, "weak", p.Weak, StatusTurns, true)
+	for _, power := range p.Powers {
+		statuses = append(statuses, Status{
+			Name:        power.Name,
+			Amount:      power.Duration,
+			Kind:        StatusPower,
+			Description: power.Description,
+		})
+	}
+	return statuses
+}
+
+// Statuses は敵にかかっている状態を返すのじゃ
+func (e *Enemy) Statuses() []Status {
+	statuses := []Status{}
+	statuses = keywordStatus(statuses, "strength", e.Strength, StatusStacks, false)
+	statuses = keywordStatus(statuses, "vulnerable", e.Vulnerable, StatusTurns, true)
+	statuses = keywordStatus(statuses, "weak", e.Weak, StatusTurns, true)
+	return statuses
+}
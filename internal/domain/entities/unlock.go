@@ -0,0 +1,34 @@
+package entities
+
+// UnlockTier はプロフィールの経験値で解放されるコンテンツの段階じゃ
+type UnlockTier struct {
+	RequiredExperience int
+	Cards              []string // 解放されるカードのIDじゃ
+	Relics             []string // 解放されるレリックのIDじゃ
+	Characters         []string // 解放されるキャラクターのIDじゃ
+}
+
+// unlockTiers は解放の段階の一覧じゃ。必要な経験値の少ない順に並べるのじゃ
+// どの段階にも載っていないコンテンツは最初から使えるのじゃ
+var unlockTiers = []UnlockTier{
+	{
+		RequiredExperience: 100,
+		Cards:              []string{"twin_strike", "iron_wave"},
+		Relics:             []string{"lantern"},
+		Characters:         []string{"silent"},
+	},
+	{
+		RequiredExperience: 300,
+		Cards:              []string{"shrug_it_off", "body_slam", "metallicize"},
+		Relics:             []string{"potion_belt"},
+	},
+	{
+		RequiredExperience: 600,
+		Cards:              []string{"entrench", "bludgeon"},
+	},
+}
+
+// GetUnlockTiers は解放の段階の一覧を返すのじゃ
+func GetUnlockTiers() []UnlockTier {
+	return unlockTiers
+}
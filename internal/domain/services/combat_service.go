@@ -2,6 +2,7 @@ package services
 
 import (
 	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
 )
 
 // CombatService は戦闘関連のロジックを提供するのじゃ
@@ -23,26 +24,35 @@ func (s *CombatService) UseCard(player *entities.Player, enemy *entities.Enemy,
 	}
 
 	card := player.Hand[cardIndex]
-	if player.Energy < card.EnergyCost {
+	cost := player.GetCardCost(card)
+	if card.Unplayable || player.Energy < cost {
 		return false
 	}
 
 	// カードの効果を実行するのじゃ
+	player.Log.Add(entities.LogCardPlayed, "log.card_played", card.Name, cost)
 	card.Effect(player, enemy)
-	player.Energy -= card.EnergyCost
+	player.Energy -= cost
 
-	// 使用したカードを捨て札に移すのじゃ
-	player.DiscardPile = append(player.DiscardPile, card)
+	// 使用したカードを捨て札に移すのじゃ。廃棄するカードは廃棄札に移すのじゃ
+	if card.Exhaust {
+		player.ExhaustPile = append(player.ExhaustPile, card)
+	} else {
+		player.DiscardPile = append(player.DiscardPile, card)
+	}
 	player.Hand = append(player.Hand[:cardIndex], player.Hand[cardIndex+1:]...)
 
 	return true
 }
 
 // DrawCards はカードを引くのじゃ
+// 手札が上限に達したら、それ以上は引かずに山札に残すのじゃ
 func (s *CombatService) DrawCards(player *entities.Player, count int) {
-	for i := 0; i < count; i++ {
+	drawn := 0
+	for i := 0; i < count && len(player.Hand) < entities.MaxHandSize; i++ {
 		// ドローパイルが空なら、捨て札をシャッフルしてドローパイルにするのじゃ
-		if len(player.DrawPile) == 0 {
+		if len(player.DrawPile) == 0 && len(player.DiscardPile) > 0 {
+			player.Log.Add(entities.LogShuffle, "log.shuffle", i18n.Plural("count.cards", len(player.DiscardPile)))
 			player.DrawPile = player.DiscardPile
 			player.DiscardPile = []entities.Card{}
 			s.DeckService.ShuffleDeck(player.DrawPile)
@@ -52,6 +62,11 @@ func (s *CombatService) DrawCards(player *entities.Player, count int) {
 		if len(player.DrawPile) > 0 {
 			player.Hand = append(player.Hand, player.DrawPile[0])
 			player.DrawPile = player.DrawPile[1:]
+			drawn++
 		}
 	}
+
+	if drawn > 0 {
+		player.Log.Add(entities.LogDraw, "log.draw", i18n.Plural("count.cards", drawn))
+	}
 }
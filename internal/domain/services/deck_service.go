@@ -2,83 +2,122 @@ package services
 
 import (
 	"math/rand"
-	"time"
 
 	"github.com/yanosea/cts/internal/domain/entities"
 )
 
 // DeckService はデッキ関連の操作を提供するのじゃ
-type DeckService struct{}
-
-// NewDeckService はDeckServiceのインスタンスを生成するのじゃ
-func NewDeckService() *DeckService {
-	return &DeckService{}
+type DeckService struct {
+	rng        *rand.Rand      // 報酬の抽選に使う乱数生成器じゃ
+	shuffleRng *rand.Rand      // シャッフルに使う乱数生成器じゃ
+	cardPool   []entities.Card // 報酬や店に出てくるカードじゃ
 }
 
-// InitializeStarterDeck は初期デッキを作成するのじゃ
-func (s *DeckService) InitializeStarterDeck() []entities.Card {
-	deck := make([]entities.Card, 0, 12)
-
-	// ストライクカードを5枚追加
-	for i := 0; i < 5; i++ {
-		deck = append(deck, entities.CreateStrikeCard())
-	}
-
-	// ディフェンドカードを4枚追加
-	for i := 0; i < 4; i++ {
-		deck = append(deck, entities.CreateDefendCard())
+// NewDeckService はDeckServiceのインスタンスを生成するのじゃ
+// シャッフルの回数が報酬の抽選に影響しないよう、乱数生成器を分けておくのじゃ
+func NewDeckService(rng *rand.Rand, shuffleRng *rand.Rand) *DeckService {
+	// 初期デッキ専用のカードと呪いは報酬に出さないのじゃ
+	cardPool := []entities.Card{}
+	for _, card := range entities.AllCards() {
+		if card.Rarity != entities.Basic && card.Type != entities.CurseCard {
+			cardPool = append(cardPool, card)
+		}
 	}
 
-	// バッシュカードを1枚追加
-	deck = append(deck, entities.CreateBashCard())
+	return &DeckService{rng: rng, shuffleRng: shuffleRng, cardPool: cardPool}
+}
 
-	// ポンメルストライクカードを2枚追加
-	for i := 0; i < 2; i++ {
-		deck = append(deck, entities.CreatePommelStrikeCard())
+// RestrictCardPool は報酬や店に出てくるカードを条件に合うものだけに絞るのじゃ
+func (s *DeckService) RestrictCardPool(allowed func(entities.Card) bool) {
+	cardPool := []entities.Card{}
+	for _, card := range s.cardPool {
+		if allowed(card) {
+			cardPool = append(cardPool, card)
+		}
 	}
+	s.cardPool = cardPool
+}
 
+// InitializeStarterDeck はキャラクターの初期デッキを作成するのじゃ
+func (s *DeckService) InitializeStarterDeck(character entities.Character) []entities.Card {
+	deck := make([]entities.Card, 0, len(character.StarterDeck))
+	for _, id := range character.StarterDeck {
+		if card, ok := entities.CreateCardByID(id); ok {
+			deck = append(deck, card)
+		}
+	}
 	return deck
 }
 
 // GetRandomCardReward はランダムな報酬カードを3枚生成するのじゃ
+// 同じカードが重ならないように選ぶのじゃ
 func (s *DeckService) GetRandomCardReward() []entities.Card {
-	reward := make([]entities.Card, 3)
-
-	// レア度の確率: コモン70%, アンコモン25%, レア5%
-	for i := 0; i < 3; i++ {
-		rarity := rand.Intn(100)
-		if rarity < 70 {
-			// コモンカード
-			cardType := rand.Intn(2)
-			if cardType == 0 {
-				reward[i] = entities.CreateStrikeCard()
-			} else {
-				reward[i] = entities.CreatePommelStrikeCard()
-			}
-		} else if rarity < 95 {
-			// アンコモンカード
-			cardType := rand.Intn(2)
-			if cardType == 0 {
-				reward[i] = entities.CreateShockwaveCard()
-			} else {
-				reward[i] = entities.CreateInflameCard()
-			}
-		} else {
-			// レアカード
-			cardType := rand.Intn(2)
-			if cardType == 0 {
-				reward[i] = entities.CreateLimitBreakCard()
-			} else {
-				reward[i] = entities.CreateDemonFormCard()
-			}
+	reward := make([]entities.Card, 0, 3)
+
+	for attempt := 0; len(reward) < 3 && attempt < 30; attempt++ {
+		card, ok := s.GetRandomCard(s.rollRarity())
+		if !ok {
+			break
+		}
+		if !containsCard(reward, card.ID) {
+			reward = append(reward, card)
 		}
 	}
 
 	return reward
 }
 
+// GetRandomRareCard はランダムなレアカードを1枚生成するのじゃ
+func (s *DeckService) GetRandomRareCard() entities.Card {
+	card, _ := s.GetRandomCard(entities.Rare)
+	return card
+}
+
+// GetRandomCard は指定されたレア度のカードを1枚選ぶのじゃ
+// そのレア度のカードが無ければ、他のレア度のカードから選ぶのじゃ
+func (s *DeckService) GetRandomCard(rarity entities.CardRarity) (entities.Card, bool) {
+	candidates := []entities.Card{}
+	for _, card := range s.cardPool {
+		if card.Rarity == rarity {
+			candidates = append(candidates, card)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = s.cardPool
+	}
+	if len(candidates) == 0 {
+		return entities.Card{}, false
+	}
+
+	card, _ := entities.CreateCardByID(candidates[s.rng.Intn(len(candidates))].ID)
+	return card, true
+}
+
+// rollRarity は報酬のレア度を抽選するのじゃ
+// レア度の確率: コモン70%, アンコモン25%, レア5%
+func (s *DeckService) rollRarity() entities.CardRarity {
+	roll := s.rng.Intn(100)
+	switch {
+	case roll < 70:
+		return entities.Common
+	case roll < 95:
+		return entities.Uncommon
+	default:
+		return entities.Rare
+	}
+}
+
+// containsCard はカードの中に指定されたIDのカードがあるかを判定するのじゃ
+func containsCard(cards []entities.Card, id string) bool {
+	for _, card := range cards {
+		if card.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 // ShuffleDeck はデッキをシャッフルするのじゃ
 func (s *DeckService) ShuffleDeck(deck []entities.Card) {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+	s.shuffleRng.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
 }
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"math/rand"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// potionDropChance は戦闘後にポーションが手に入る確率（%）じゃ
+const potionDropChance = 40
+
+// PotionService はポーション関連の操作を提供するのじゃ
+type PotionService struct {
+	rng *rand.Rand
+}
+
+// NewPotionService はPotionServiceのインスタンスを生成するのじゃ
+func NewPotionService(rng *rand.Rand) *PotionService {
+	return &PotionService{rng: rng}
+}
+
+// GetRandomPotion はランダムなポーションを1つ生成するのじゃ
+func (s *PotionService) GetRandomPotion() entities.Potion {
+	switch s.rng.Intn(3) {
+	case 0:
+		return entities.CreateFirePotion()
+	case 1:
+		return entities.CreateBlockPotion()
+	default:
+		return entities.CreateStrengthPotion()
+	}
+}
+
+// RollPotionDrop は戦闘後にポーションが手に入るかを決めるのじゃ
+func (s *PotionService) RollPotionDrop() bool {
+	return s.rng.Intn(100) < potionDropChance
+}
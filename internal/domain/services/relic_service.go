@@ -0,0 +1,54 @@
+package services
+
+import (
+	"math/rand"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// RelicService はレリック関連の操作を提供するのじゃ
+type RelicService struct {
+	rng       *rand.Rand
+	relicPool []entities.Relic // 報酬や店に出てくるレリックじゃ
+}
+
+// NewRelicService はRelicServiceのインスタンスを生成するのじゃ
+func NewRelicService(rng *rand.Rand) *RelicService {
+	// キャラクターの初期レリックは報酬に出さないのじゃ
+	relicPool := []entities.Relic{}
+	for _, relic := range entities.AllRelics() {
+		if !entities.IsStarterRelic(relic.ID) {
+			relicPool = append(relicPool, relic)
+		}
+	}
+
+	return &RelicService{rng: rng, relicPool: relicPool}
+}
+
+// RestrictRelicPool は報酬や店に出てくるレリックを条件に合うものだけに絞るのじゃ
+func (s *RelicService) RestrictRelicPool(allowed func(entities.Relic) bool) {
+	relicPool := []entities.Relic{}
+	for _, relic := range s.relicPool {
+		if allowed(relic) {
+			relicPool = append(relicPool, relic)
+		}
+	}
+	s.relicPool = relicPool
+}
+
+// GetRandomRelic はプレイヤーがまだ持っていないレリックを1つ選ぶのじゃ
+// 選べるレリックが無ければfalseを返すのじゃ
+func (s *RelicService) GetRandomRelic(player *entities.Player) (entities.Relic, bool) {
+	candidates := []entities.Relic{}
+	for _, relic := range s.relicPool {
+		if !player.HasRelic(relic.ID) {
+			candidates = append(candidates, relic)
+		}
+	}
+	if len(candidates) == 0 {
+		return entities.Relic{}, false
+	}
+
+	relic, _ := entities.CreateRelicByID(candidates[s.rng.Intn(len(candidates))].ID)
+	return relic, true
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"math/rand"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// goldRange は敵の強さごとに戦闘で得られるゴールドの幅じゃ
+var goldRange = map[entities.NodeType][2]int{
+	entities.NodeEnemy: {10, 20},
+	entities.NodeElite: {25, 35},
+	entities.NodeBoss:  {95, 105},
+}
+
+// RewardService は戦闘の報酬を決めるのじゃ
+type RewardService struct {
+	rng *rand.Rand
+}
+
+// NewRewardService はRewardServiceのインスタンスを生成するのじゃ
+func NewRewardService(rng *rand.Rand) *RewardService {
+	return &RewardService{rng: rng}
+}
+
+// RollGold は敵の強さに応じた幅からゴールドの量を決めるのじゃ
+// 敵のいないノードでは何も得られないのじゃ
+func (s *RewardService) RollGold(nodeType entities.NodeType) int {
+	span, ok := goldRange[nodeType]
+	if !ok {
+		return 0
+	}
+	return span[0] + s.rng.Intn(span[1]-span[0]+1)
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// スコアの配点じゃ
+const (
+	pointsPerFloor        = 5
+	pointsPerEnemy        = 2
+	pointsPerElite        = 10
+	pointsPerBoss         = 50
+	pointsPerPerfectFight = 10
+	goldPerPoint          = 10
+	pointsPerCurse        = -15
+)
+
+// ScoreService はランのスコアを計算するのじゃ
+type ScoreService struct{}
+
+// NewScoreService はScoreServiceのインスタンスを生成するのじゃ
+func NewScoreService() *ScoreService {
+	return &ScoreService{}
+}
+
+// Calculate はプレイヤーの状態と戦績からスコアの内訳を計算するのじゃ
+// 0点の項目は内訳に載せないのじゃ
+// 内訳はランの履歴にそのまま残すので、計算したときの言語の文字列にするのじゃ
+func (s *ScoreService) Calculate(player *entities.Player, stats entities.RunStats) entities.Score {
+	score := entities.Score{Items: []entities.ScoreItem{}}
+
+	addCount := func(key string, count, points int) {
+		if count > 0 {
+			score.AddItem(i18n.T(key), fmt.Sprintf("%d x %d", count, points), count*points)
+		}
+	}
+
+	addCount("score.floors", stats.FloorsClimbed, pointsPerFloor)
+	addCount("score.enemies", stats.EnemiesKilled, pointsPerEnemy)
+	addCount("score.elites", stats.ElitesKilled, pointsPerElite)
+	addCount("score.bosses", stats.BossesKilled, pointsPerBoss)
+	addCount("score.perfect_fights", stats.PerfectFights, pointsPerPerfectFight)
+
+	if player.Gold >= goldPerPoint {
+		score.AddItem(i18n.T("score.gold"), i18n.T("common.gold_amount", i18n.Number(player.Gold)), player.Gold/goldPerPoint)
+	}
+
+	s.addDeckBonuses(&score, player.Deck)
+
+	return score
+}
+
+// addDeckBonuses はデッキの構成によるボーナスと呪いのペナルティを加えるのじゃ
+func (s *ScoreService) addDeckBonuses(score *entities.Score, deck []entities.Card) {
+	rares, powers, curses := 0, 0, 0
+	for _, card := range deck {
+		if card.Rarity == entities.Rare && card.Type != entities.CurseCard {
+			rares++
+		}
+		switch card.Type {
+		case entities.PowerCard:
+			powers++
+		case entities.CurseCard:
+			curses++
+		}
+	}
+
+	if len(deck) >= 30 {
+		score.AddItem(i18n.T("score.big_deck"), i18n.T("score.big_deck_detail", i18n.Plural("count.cards", len(deck))), 25)
+	}
+	if rares >= 3 {
+		score.AddItem(i18n.T("score.rares"), i18n.T("score.rares_detail", i18n.Plural("count.cards", rares)), 25)
+	}
+	if powers >= 3 {
+		score.AddItem(i18n.T("score.powers"), i18n.T("score.powers_detail", i18n.Plural("count.cards", powers)), 20)
+	}
+	if curses > 0 {
+		score.AddItem(i18n.T("score.curses"), fmt.Sprintf("%d x %d", curses, pointsPerCurse), curses*pointsPerCurse)
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"math/rand"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// 店の商品の基本価格じゃ
+var cardPrices = map[entities.CardRarity]int{
+	entities.Common:   50,
+	entities.Uncommon: 75,
+	entities.Rare:     150,
+}
+
+const (
+	relicPrice  = 150
+	potionPrice = 50
+)
+
+// ShopService は店の品揃えを決めるのじゃ
+type ShopService struct {
+	rng           *rand.Rand
+	deckService   *DeckService
+	relicService  *RelicService
+	potionService *PotionService
+}
+
+// NewShopService はShopServiceのインスタンスを生成するのじゃ
+func NewShopService(rng *rand.Rand, deckService *DeckService, relicService *RelicService, potionService *PotionService) *ShopService {
+	return &ShopService{
+		rng:           rng,
+		deckService:   deckService,
+		relicService:  relicService,
+		potionService: potionService,
+	}
+}
+
+// GenerateShop はカード5枚、レリック1つ、ポーション2つの品揃えを作るのじゃ
+func (s *ShopService) GenerateShop(player *entities.Player) *entities.Shop {
+	shop := &entities.Shop{Items: []entities.ShopItem{}}
+
+	for _, rarity := range []entities.CardRarity{entities.Common, entities.Common, entities.Uncommon, entities.Uncommon, entities.Rare} {
+		card, ok := s.deckService.GetRandomCard(rarity)
+		if !ok {
+			break
+		}
+		shop.Items = append(shop.Items, entities.ShopItem{
+			Kind:  entities.ShopCard,
+			Card:  card,
+			Price: s.varyPrice(cardPrices[card.Rarity]),
+		})
+	}
+
+	if relic, ok := s.relicService.GetRandomRelic(player); ok {
+		shop.Items = append(shop.Items, entities.ShopItem{
+			Kind:  entities.ShopRelic,
+			Relic: relic,
+			Price: s.varyPrice(relicPrice),
+		})
+	}
+
+	for n := 0; n < 2; n++ {
+		shop.Items = append(shop.Items, entities.ShopItem{
+			Kind:   entities.ShopPotion,
+			Potion: s.potionService.GetRandomPotion(),
+			Price:  s.varyPrice(potionPrice),
+		})
+	}
+
+	return shop
+}
+
+// varyPrice は価格を±10%の範囲でばらつかせるのじゃ
+func (s *ShopService) varyPrice(base int) int {
+	return base * (90 + s.rng.Intn(21)) / 100
+}
@@ -0,0 +1,569 @@
+package i18n
+
+// english は英語のメッセージの一覧じゃ
+var english = catalog{
+	// 共通の表記
+	"number.separator":   ",",
+	"common.unknown":     "Unknown",
+	"common.gold_amount": "%s gold",
+	"count.cards.one":    "%d card",
+	"count.cards.other":  "%d cards",
+	"intent.attack":      "Attack",
+	"intent.defend":      "Defend",
+	"intent.buff":        "Buff",
+	"enemy.slime":        "Slime",
+	"enemy.jaw_worm":     "Jaw Worm",
+
+	// カードの共通の表記
+	"card.rarity.basic":    "Starter",
+	"card.rarity.common":   "Common",
+	"card.rarity.uncommon": "Uncommon",
+	"card.rarity.rare":     "Rare",
+	"card.type.attack":     "Attack",
+	"card.type.skill":      "Skill",
+	"card.type.power":      "Power",
+	"card.type.curse":      "Curse",
+	"card.upgraded_name":   "%s+",
+	"card.text.draw.one":   "Draw %d card.",
+	"card.text.draw.other": "Draw %d cards.",
+
+	// カード
+	"card.strike.name":                      "Strike",
+	"card.strike.description":               "Deal %d damage.",
+	"card.defend.name":                      "Defend",
+	"card.defend.description":               "Gain %d Block.",
+	"card.bash.name":                        "Bash",
+	"card.bash.description":                 "Deal %d damage. Apply %d Vulnerable.",
+	"card.pommel_strike.name":               "Pommel Strike",
+	"card.pommel_strike.description":        "Deal %d damage. %s",
+	"card.shockwave.name":                   "Shockwave",
+	"card.shockwave.description":            "Apply %d Vulnerable and %d Weak to ALL enemies. Exhaust.",
+	"card.inflame.name":                     "Inflame",
+	"card.inflame.description":              "Gain %d Strength.",
+	"card.limit_break.name":                 "Limit Break",
+	"card.limit_break.description":          "Double your Strength. Exhaust.",
+	"card.demon_form.name":                  "Demon Form",
+	"card.demon_form.description":           "At the start of your turn, gain %d Strength.",
+	"card.twin_strike.name":                 "Twin Strike",
+	"card.twin_strike.description":          "Deal %d damage twice.",
+	"card.iron_wave.name":                   "Iron Wave",
+	"card.iron_wave.description":            "Gain %d Block. Deal %d damage.",
+	"card.shrug_it_off.name":                "Shrug It Off",
+	"card.shrug_it_off.description":         "Gain %d Block. %s",
+	"card.body_slam.name":                   "Body Slam",
+	"card.body_slam.description":            "Deal damage equal to your current Block.",
+	"card.metallicize.name":                 "Metallicize",
+	"card.metallicize.description":          "At the end of your turn, gain %d Block.",
+	"card.entrench.name":                    "Entrench",
+	"card.entrench.description":             "Double your Block.",
+	"card.bludgeon.name":                    "Bludgeon",
+	"card.bludgeon.description":             "Deal %d damage.",
+	"card.neutralize.name":                  "Neutralize",
+	"card.neutralize.description":           "Deal %d damage. Apply %d Weak.",
+	"card.survivor.name":                    "Survivor",
+	"card.survivor.description":             "Gain %d Block.",
+	"card.quick_slash.name":                 "Quick Slash",
+	"card.quick_slash.description":          "Deal %d damage. %s",
+	"card.dagger_spray.name":                "Dagger Spray",
+	"card.dagger_spray.description":         "Deal %d damage twice.",
+	"card.backflip.name":                    "Backflip",
+	"card.backflip.description":             "Gain %d Block. %s",
+	"card.leg_sweep.name":                   "Leg Sweep",
+	"card.leg_sweep.description":            "Apply %d Weak. Gain %d Block.",
+	"card.dash.name":                        "Dash",
+	"card.dash.description":                 "Gain %d Block. Deal %d damage.",
+	"card.adrenaline.name":                  "Adrenaline",
+	"card.adrenaline.description":           "Gain %d Energy. %s Exhaust.",
+	"card.die_die_die.name":                 "Die Die Die",
+	"card.die_die_die.description":          "Deal %d damage.",
+	"card.swift_strike.name":                "Swift Strike",
+	"card.swift_strike.description":         "Deal %d damage.",
+	"card.good_instincts.name":              "Good Instincts",
+	"card.good_instincts.description":       "Gain %d Block.",
+	"card.injury.name":                      "Injury",
+	"card.injury.description":               "Unplayable.",
+	"card.limit_break.description_upgraded": "Double your Strength.",
+
+	// 用語集
+	"keyword.strength.name":          "Strength",
+	"keyword.strength.description":   "Attacks deal additional damage equal to this value.",
+	"keyword.dexterity.name":         "Dexterity",
+	"keyword.dexterity.description":  "Block gained from cards is increased by this value.",
+	"keyword.vulnerable.name":        "Vulnerable",
+	"keyword.vulnerable.description": "Takes 50% more damage from Attacks. Decreases by 1 at the end of the turn.",
+	"keyword.weak.name":              "Weak",
+	"keyword.weak.description":       "Deals 25% less damage with Attacks. Decreases by 1 at the end of the turn.",
+	"keyword.block.name":             "Block",
+	"keyword.block.description":      "Prevents damage up to this value.",
+	"keyword.energy.name":            "Energy",
+	"keyword.energy.description":     "Needed to play cards. Refills to its maximum at the start of each turn.",
+	"keyword.exhaust.name":           "Exhaust",
+	"keyword.exhaust.description":    "Moves to the exhaust pile when played and does not return this combat.",
+	"keyword.unplayable.name":        "Unplayable",
+	"keyword.unplayable.description": "Cannot be played from your hand.",
+	"keyword.power.name":             "Power",
+	"keyword.power.description":      "Its effect lasts for the rest of the combat once played.",
+	"keyword.curse.name":             "Curse",
+	"keyword.curse.description":      "A useless card that clogs your deck.",
+
+	// レリック
+	"relic.burning_blood.name":            "Burning Blood",
+	"relic.burning_blood.description":     "At the end of combat, heal %d HP.",
+	"relic.anchor.name":                   "Anchor",
+	"relic.anchor.description":            "Start each combat with %d Block.",
+	"relic.vajra.name":                    "Vajra",
+	"relic.vajra.description":             "At the start of each combat, gain %d Strength.",
+	"relic.blood_vial.name":               "Blood Vial",
+	"relic.blood_vial.description":        "At the start of each combat, heal %d HP.",
+	"relic.lantern.name":                  "Lantern",
+	"relic.lantern.description":           "Gain %d Energy at the start of each combat.",
+	"relic.potion_belt.name":              "Potion Belt",
+	"relic.potion_belt.description":       "Gain %d potion slots.",
+	"relic.ring_of_the_snake.name":        "Ring of the Snake",
+	"relic.ring_of_the_snake.description": "At the start of each combat, draw %d additional cards.",
+
+	// ポーション
+	"potion.fire_potion.name":            "Fire Potion",
+	"potion.fire_potion.description":     "Deal %d damage.",
+	"potion.block_potion.name":           "Block Potion",
+	"potion.block_potion.description":    "Gain %d Block.",
+	"potion.strength_potion.name":        "Strength Potion",
+	"potion.strength_potion.description": "Gain %d Strength.",
+
+	// キャラクター
+	"character.ironclad.name":        "The Ironclad",
+	"character.ironclad.description": "A warrior bound to demonic power. Overwhelms foes with high HP and Strength.",
+	"character.silent.name":          "The Silent",
+	"character.silent.description":   "A huntress from the foglands. Wears enemies down with Weak and many small attacks.",
+
+	// アセンション
+	"ascension.none":               "No change",
+	"ascension.more_elites":        "More Elites appear.",
+	"ascension.stronger_enemies":   "Normal enemies gain 1 Strength.",
+	"ascension.stronger_elites":    "Elites gain 1 Strength.",
+	"ascension.stronger_boss":      "The boss gains 2 Strength.",
+	"ascension.tougher_enemies":    "Normal enemies have 10% more HP.",
+	"ascension.tougher_elites":     "Elites have 10% more HP.",
+	"ascension.tougher_boss":       "The boss has 10% more HP.",
+	"ascension.less_healing":       "Rest sites heal 5% less.",
+	"ascension.injured_start":      "Start each run with reduced HP.",
+	"ascension.starting_curse":     "Start each run with a Curse.",
+	"ascension.fewer_potion_slots": "One fewer potion slot.",
+	"ascension.less_gold":          "Combats give 20% less gold.",
+	"ascension.lower_max_health":   "Max HP is reduced by 4.",
+
+	// デイリーチャレンジの変化
+	"daily.random_rare":     "Start with a random Rare card.",
+	"daily.double_elites":   "Twice as many Elites appear.",
+	"daily.no_rest_sites":   "No rest sites appear.",
+	"daily.expensive_cards": "Cards cost 1 more Energy.",
+
+	// マップのノード
+	"node.enemy":    "Enemy",
+	"node.elite":    "Elite",
+	"node.boss":     "Boss",
+	"node.rest":     "Rest",
+	"node.shop":     "Shop",
+	"node.treasure": "Treasure",
+	"node.event":    "Event",
+
+	// ランの結果
+	"result.victory":   "Victory",
+	"result.defeat":    "Defeat",
+	"result.abandoned": "Abandoned",
+
+	// 戦闘ログ
+	"combat.player":             "You",
+	"log.combat_start":          "Floor %d: fight with %s",
+	"log.turn":                  "Turn %d",
+	"log.damage":                "%s took %d damage, -%d HP",
+	"log.damage_blocked":        "%s took %d damage, all blocked",
+	"log.damage_partly_blocked": "%s took %d damage, blocked %d, -%d HP",
+	"log.block":                 "%s gained %d Block (total %d)",
+	"log.heal":                  "%s healed %d HP",
+	"log.strength":              "%s Strength %+d (total %d)",
+	"log.strength_set":          "%s Strength is now %d",
+	"log.dexterity":             "%s Dexterity %+d (total %d)",
+	"log.vulnerable":            "%s got %d Vulnerable (total %d)",
+	"log.weak":                  "%s got %d Weak (total %d)",
+	"log.relic_triggered":       "Relic \"%s\" triggered",
+	"log.power_gained":          "Gained power \"%s\"",
+	"log.power_triggered":       "Power \"%s\" triggered",
+	"log.enemy_move":            "%s uses: %s",
+	"log.card_played":           "Played \"%s\" (%d Energy)",
+	"log.shuffle":               "Shuffled %s from the discard pile into the draw pile",
+	"log.draw":                  "Drew %s",
+	"log.potion":                "Used potion \"%s\"",
+	"log.enemy_defeated":        "Defeated %s",
+	"log.player_defeated":       "Slain by %s",
+	"enemy.boss":                "Giant %s",
+	"log.export_header":         "Seed: %d\nCharacter: %s\nAscension: %d\n\n",
+
+	// スコアの内訳
+	"score.floors":          "Floors climbed",
+	"score.enemies":         "Enemies slain",
+	"score.elites":          "Elites slain",
+	"score.bosses":          "Bosses slain",
+	"score.perfect_fights":  "Perfect fights",
+	"score.gold":            "Gold",
+	"score.big_deck":        "Overstuffed",
+	"score.big_deck_detail": "Deck of %s",
+	"score.rares":           "Collector",
+	"score.rares_detail":    "Rares: %s",
+	"score.powers":          "Powerhouse",
+	"score.powers_detail":   "Powers: %s",
+	"score.curses":          "Cursed",
+
+	// エラー
+	"error.no_combat_log_store": "There is nowhere to export the combat log",
+	"error.run_not_found":       "No run with ID %d",
+	"error.unknown_character":   "Character %s does not exist",
+	"error.character_locked":    "Character %s is not unlocked yet",
+	"error.ascension_locked":    "Ascension %d is not unlocked yet (max: %d)",
+	"error.no_saved_run":        "There is no suspended run",
+	"error.daily_date":          "Failed to read the daily challenge date",
+	"error.saved_map_position":  "The map position in the save data is invalid",
+
+	// 保存先と画面の初期化のエラー
+	"error.theme_open":            "Could not open the theme file",
+	"error.theme_broken":          "The theme file is broken",
+	"error.unknown_theme":         "Theme %s does not exist (available themes: %v)",
+	"error.unknown_theme_color":   "Color %s in the theme file does not exist",
+	"error.unknown_theme_style":   "Style %s in the theme file does not exist",
+	"error.screen_init":           "Failed to initialize the screen",
+	"error.save_open":             "Could not open the save data",
+	"error.save_broken":           "The save data is broken",
+	"error.save_encode":           "Failed to encode the save data",
+	"error.save_delete":           "Failed to delete the save data",
+	"error.history_encode":        "Failed to encode the run record",
+	"error.history_dir":           "Failed to create the history directory",
+	"error.history_open":          "Could not open the history file",
+	"error.history_write":         "Failed to write to the history file",
+	"error.history_broken_line":   "Line %d of the history file is broken",
+	"error.history_read":          "Failed to read the history file",
+	"error.keymap_open":           "Could not open the keymap",
+	"error.keymap_broken":         "The keymap is broken",
+	"error.unknown_keymap":        "Keymap %s does not exist (available keymaps: %v)",
+	"error.unknown_keymap_action": "Keymap action %s does not exist",
+	"error.leaderboard_encode":    "Failed to encode the leaderboard",
+	"error.leaderboard_open":      "Could not open the leaderboard",
+	"error.leaderboard_export":    "Failed to export the leaderboard",
+	"error.leaderboard_broken":    "The leaderboard is broken",
+	"error.combat_log_export":     "Failed to export the combat log",
+	"error.home_dir":              "Failed to find the home directory",
+	"error.make_dir":              "Failed to create the directory",
+	"error.file_write":            "Failed to write the file",
+	"error.file_replace":          "Failed to replace the file",
+	"error.profile_open":          "Could not open the profile",
+	"error.profile_broken":        "The profile is broken",
+	"error.profile_encode":        "Failed to encode the profile",
+	"error.settings_open":         "Could not open the settings file",
+	"error.settings_broken":       "The settings file is broken",
+	"error.settings_encode":       "Failed to encode the settings",
+	"error.unknown_frame_rate":    "Unsupported frame cap %d %v",
+	"error.unknown_log_verbosity": "Unknown combat log verbosity %s",
+	"error.unknown_lang":          "Language %s is not supported %v",
+
+	// 戦闘ログの欄
+	"log.title":          " Log %s ",
+	"log.title_scrolled": " Log %s ↓%d ",
+
+	// 図鑑
+	"compendium.title":           "Compendium",
+	"compendium.cards_red":       "Cards (Ironclad)",
+	"compendium.cards_green":     "Cards (Silent)",
+	"compendium.cards_colorless": "Cards (Colorless)",
+	"compendium.relics":          "Relics",
+	"compendium.potions":         "Potions",
+	"compendium.locked":          "  ??? (locked)",
+	"card.name_with_cost":        "%s (%d Energy)",
+	"error.profile_load":         "Could not load the profile: %v",
+	"help.compendium":            "Keys: %s:select %s:details %s:back",
+
+	// 詳細の表示
+	"inspect.title":            " Details (%d/%d) ",
+	"inspect.card_kind":        "%s / %s / %d Energy",
+	"inspect.cost":             "Cost: %d → %d",
+	"inspect.damage":           "Damage",
+	"inspect.block":            "Block",
+	"inspect.intention":        "Intent: %s",
+	"inspect.intention_damage": "Intent: %s %d damage",
+	"inspect.enemy":            "Enemy",
+	"inspect.enemy_health":     "HP: %d/%d  Block: %d",
+	"inspect.buff":             "Buff",
+	"inspect.debuff":           "Debuff",
+	"inspect.status_kind":      "%s / %s",
+	"inspect.permanent":        "Lasts until the end of combat",
+	"inspect.stacks":           "Stacks: %d",
+	"inspect.turns_left.one":   "%d turn left",
+	"inspect.turns_left.other": "%d turns left",
+	"inspect.relic":            "Relic",
+	"inspect.potion":           "Potion",
+	"inspect.numbers":          "Current values:",
+	"inspect.upgrade":          "When upgraded:",
+	"inspect.keywords":         "Keywords:",
+	"help.inspect":             " %s:switch %s:close ",
+
+	// カードの束と手札
+	"combat.block":            "Block %d",
+	"hand.more_left":          "◀ %s",
+	"hand.more_right":         "%s ▶",
+	"pile.deck":               "Deck",
+	"pile.draw":               "Draw pile",
+	"pile.discard":            "Discard pile",
+	"pile.exhaust":            "Exhaust pile",
+	"pile.sort_cost":          "by cost",
+	"pile.sort_type":          "by type",
+	"pile.sort_rarity":        "by rarity",
+	"pile.sort_name":          "by name",
+	"pile.title":              " %s (%s) %s ",
+	"pile.empty":              "No cards",
+	"help.pile":               " %s:select %s:details %s:sort %s:deck ",
+	"help.pile_combat":        " %s:select %s:details %s:sort %s:deck %s:draw %s:discard %s:exhaust ",
+	"animation.normal":        "Normal",
+	"animation.fast":          "Fast",
+	"animation.off":           "Off",
+	"animation.block":         "Block %+d",
+	"error.unknown_animation": "Unknown animation speed %s %v",
+	"keymap.conflict":         "%s is bound to both %s and %s",
+	"error.keymap_conflict":   "Conflicting key bindings: %s",
+
+	// 画面
+	"header.daily":                   "Daily %s",
+	"header.ascension":               "Ascension %d",
+	"size.warning":                   "The screen is too small!",
+	"size.current":                   "Current: %dx%d Minimum: %dx%d",
+	"menu.continue":                  "Continue",
+	"menu.new_run":                   "New run",
+	"menu.setup":                     "Character and ascension",
+	"menu.compendium":                "Compendium",
+	"menu.history":                   "Run history",
+	"menu.settings":                  "Settings",
+	"menu.quit":                      "Quit",
+	"menu.setup_summary":             "Character: %s  Ascension: %d",
+	"setup.title":                    "Character and Ascension",
+	"setup.choose_later":             "Choose when the run starts",
+	"setup.choose_later_description": "Shows the character selection when a run starts",
+	"setup.character":                "Character: < %s >",
+	"setup.ascension":                "Ascension: < %d > (max: %d)",
+	"pause.title":                    "Paused",
+	"pause.resume":                   "Resume",
+	"pause.settings":                 "Settings",
+	"pause.save_quit":                "Save & quit to menu",
+	"pause.abandon":                  "Abandon run",
+	"pause.abandon_title":            "Abandon this run?",
+	"pause.abandon_warning":          "An abandoned run cannot be resumed",
+	"pause.abandon_no":               "Cancel",
+	"pause.abandon_yes":              "Abandon",
+	"settings.title":                 "Settings",
+	"settings.animation":             "Animation: < %s >",
+	"settings.language":              "Language: < %s >",
+	"settings.theme":                 "Theme: < %s >",
+	"settings.frame_rate":            "Frame cap: < %s >",
+	"settings.fps":                   "%d FPS",
+	"settings.keymap":                "Keymap: < %s >",
+	"settings.confirm_end_turn":      "Confirm end turn: < %s >",
+	"settings.fast_enemy_turns":      "Fast enemy turns: < %s >",
+	"settings.damage_preview":        "Damage preview: < %s >",
+	"settings.log_verbosity":         "Combat log: < %s >",
+	"settings.auto":                  "Auto",
+	"settings.on":                    "On",
+	"settings.off":                   "Off",
+	"log_verbosity.all":              "All",
+	"log_verbosity.normal":           "Normal",
+	"log_verbosity.brief":            "Brief",
+	"error.settings_apply":           "Could not change the setting: %v",
+	"combat.energy":                  "Energy: %d/%d",
+	"combat.gold":                    "Gold: %s",
+	"combat.intent":                  "Intent: %s %d",
+	"combat.target_hint":             "Click the enemy to play the card",
+	"combat.piles":                   "%s:draw %s %s:discard %s %s:exhaust %s %s:deck",
+	"combat.end_turn_button":         "[End turn]",
+	"end_turn_prompt.title":          "End turn",
+	"end_turn_prompt.body":           "You still have %d energy and playable cards. End your turn?",
+	"combat.potions":                 "Potions (%d/%d):",
+	"combat.potion":                  "[%s: %s]",
+	"map.title":                      "Dungeon Map",
+	"map.floor":                      "Current floor: %d",
+	"map.choices":                    "Next nodes:",
+	"map.node":                       "%s (floor %d)",
+	"map.modifiers":                  "Modifiers: %s",
+	"map.player":                     "HP: %d/%d  Gold: %s",
+	"rest.title":                     "Rest Site",
+	"rest.heal":                      "Rest (heal %d%% of HP)",
+	"rest.upgrade":                   "Upgrade a card (not implemented)",
+	"rest.player":                    "HP: %d/%d",
+	"character_select.title":         "Choose a Character",
+	"character_select.row":           "%s  HP:%d  Gold:%s",
+	"character_select.locked":        "%s  (locked)",
+	"character_select.starter_relic": "Starter relic: %s - %s",
+	"shop.title":                     "Shop",
+	"shop.price":                     "%3d gold",
+	"shop.sold_out":                  "Sold out",
+	"shop.gold":                      "Gold: %s",
+	"event.title":                    "Event",
+	"event.not_implemented":          "Events are not implemented yet",
+	"event.continue":                 "Press any key to return to the map...",
+	"reward.victory":                 "Enemy defeated!",
+	"reward.gold":                    "%s gold",
+	"reward.potion":                  "Potion: %s",
+	"reward.relic":                   "Relic: %s - %s",
+	"reward.card_reward":             "Add a card to your deck",
+	"reward.proceed":                 "Proceed",
+	"reward.cards":                   "Card reward",
+	"reward.card":                    "%s (%d energy) - %s",
+	"reward.potion_full":             "Your potion slots are full",
+	"reward.leave_title":             "Leave rewards behind?",
+	"reward.leave_warning.one":       "You have %d unclaimed reward",
+	"reward.leave_warning.other":     "You have %d unclaimed rewards",
+	"reward.leave_no":                "Cancel",
+	"reward.leave_yes":               "Leave them",
+	"gameover.title":                 "Game Over",
+	"gameover.victory":               "Victory!",
+	"gameover.score":                 "Score breakdown:",
+	"gameover.total":                 "Total",
+	"gameover.continue":              "Press any key to return to the menu...",
+	"gameover.log_exported":          "Exported the combat log: %s",
+	"gameover.ascension_unlocked":    "Ascension %d unlocked! %s",
+	"gameover.new_unlocks":           "Newly unlocked: %s",
+	"unlocks.title":                  "Unlocks",
+	"unlocks.experience":             "Experience: %d",
+	"unlocks.next_tier":              "Next tier %s %d/%d",
+	"unlocks.all_unlocked":           "Every tier is unlocked!",
+	"unlocks.locked":                 "locked",
+	"unlocks.unlocked":               "unlocked",
+	"unlocks.tier":                   "Tier %d (%d experience) %s",
+	"unlocks.card":                   "Card:%s",
+	"unlocks.relic":                  "Relic:%s",
+	"unlocks.character":              "Character:%s",
+	"history.title":                  "Run History",
+	"history.empty":                  "No runs recorded",
+	"history.row":                    "#%-4d %s  %s  floor %-2d %s  score %d",
+	"history.summary":                "#%-4d %s  %-8s floor %-2d %s A%d  %d gold  score %d",
+	"history.run":                    "Run #%d  %s",
+	"history.run_id":                 "Run #%d",
+	"history.date":                   "Date: %s",
+	"history.seed":                   "Seed: %d",
+	"history.character":              "Character: %s  Ascension: %d",
+	"history.character_only":         "Character: %s",
+	"history.ascension":              "Ascension: %d",
+	"history.result":                 "Result: %s  Floor reached: %d",
+	"history.result_only":            "Result: %s",
+	"history.floor":                  "Floor reached: %d",
+	"history.killed_by":              "Killed by: %s",
+	"history.gold":                   "Gold: %s  Play time: %s",
+	"history.gold_only":              "Gold: %s",
+	"history.duration":               "Play time: %s",
+	"history.score":                  "Score: %s",
+	"history.deck":                   "Deck (%s): %s",
+	"history.relics":                 "Relics: %s",
+	"history.none":                   "none",
+
+	// 操作説明
+	"help.menu":             "Keys: %s:select %s:confirm %s:quit",
+	"help.setup":            "Keys: %s:select %s:change %s:confirm %s:back",
+	"help.end_turn_prompt":  "Keys: %s:end turn  other keys:back",
+	"help.pause":            "Keys: %s:select %s:confirm %s:back",
+	"help.settings":         "Keys: %s:select %s:change %s:back",
+	"help.history":          "Keys: %s:select %s:details %s:back",
+	"help.overlay":          "Keys: %s:back",
+	"help.combat":           "%s:select %s:confirm %s-%s:cards %s:end turn %s:potions %s:inspect %s:menu",
+	"help.map":              "Keys: %s:select %s:confirm %s:deck %s:inspect %s:menu",
+	"help.character_select": "Keys: %s:select %s:confirm %s:menu",
+	"help.shop":             "Keys: %s:select %s:buy %s:leave %s:inspect %s:menu",
+	"help.reward":           "%s:select %s:claim %s:discard %s:deck %s:inspect %s:menu",
+	"help.reward_cards":     "%s:select %s:take %s:skip this card reward %s:inspect %s:back",
+	"help.gameover":         "%s: run history %s: unlocks %s: export the combat log",
+
+	// 行単位の遊び方
+	"error.command_unknown":    "Unknown command: %s. Type help for a list of commands",
+	"error.command_number":     "%s needs a number",
+	"error.command_not_number": "Numbers must be 1 or more: %s",
+	"error.command_extra":      "Unexpected words: %s",
+	"line.confirm_end_turn":    "You still have %d energy and playable cards. Type end again to end your turn",
+	"line.not_here":            "That command is not available here",
+	"line.no_card":             "There is no card %d in your hand",
+	"line.cannot_play":         "You cannot play %s now",
+	"line.no_potion":           "There is no potion %d",
+	"line.no_enemy":            "There is no enemy %d",
+	"line.no_choice":           "There is no choice %d",
+	"line.cannot_buy":          "You cannot buy %s",
+	"line.locked":              "%s is still locked",
+	"line.menu":                "Main menu",
+	"line.enemy":               "Enemy %d: %s  HP %d/%d  Block %d  %s",
+	"line.player":              "You: HP %d/%d  Block %d  Energy %d/%d",
+	"line.statuses":            "  Statuses: %s",
+	"line.hand":                "Hand:",
+	"line.card":                "%d. %s (cost %s) %s",
+	"line.unplayable":          "[unplayable]",
+	"line.piles":               "Draw pile %s, discard pile %s, exhaust pile %s",
+	"line.pile_card":           "%s (cost %d) %s",
+	"line.hint.pick":           "Type a number to choose. Type help for commands",
+	"line.hint.map":            "Type map and a number to move on. Type deck to list your deck",
+	"line.hint.combat":         "Type play and a number to play a card, potion and a number to drink a potion, or end to end your turn",
+	"line.hint.reward":         "Type a number to claim a reward, or skip to move on",
+	"line.hint.reward_cards":   "Type a number to take a card, or skip to take none",
+	"line.hint.shop":           "Type a number to buy, or leave to exit the shop",
+	"line.hint.continue":       "Type skip to continue",
+	"line.help.title":          "Commands:",
+	"line.help.look":           "look: describe the current situation again",
+	"line.help.play":           "play N [on T]: play card N from your hand, optionally on enemy T",
+	"line.help.potion":         "potion N [on T]: drink potion N",
+	"line.help.end":            "end: end your turn",
+	"line.help.map":            "map N: move to path N on the map",
+	"line.help.pick":           "pick N, or just N: choose option N",
+	"line.help.skip":           "skip, leave: skip a reward, leave the shop or continue",
+	"line.help.piles":          "deck, draw, discard, exhaust: list the cards in a pile",
+	"line.help.quit":           "quit: open the pause menu during a run, or close it again. Exits the game from the menu",
+
+	// コマンドライン
+	"count.records.one":           "%d record",
+	"count.records.other":         "%d records",
+	"flag.ascension":              "Ascension level of a new run (0-20)",
+	"flag.character":              "Character ID for a new run (chosen when the run starts if omitted)",
+	"flag.daily":                  "Play today's daily challenge without the menu",
+	"flag.name":                   "Name shown on the leaderboard",
+	"flag.theme":                  "Color theme %v (follows the theme file or NO_COLOR if omitted)",
+	"flag.keymap":                 "Key bindings %v (follows the keymap file if omitted)",
+	"flag.fps":                    "Maximum redraws per second %v",
+	"flag.animation":              "Combat animation speed %v",
+	"flag.lang":                   "Display language %v (follows LANG if omitted)",
+	"flag.line":                   "Play by typing one command per line (for screen readers and scripts)",
+	"flag.daily_date":             "Date to show (YYYY-MM-DD, today if omitted)",
+	"flag.daily_export_date":      "Date to export (YYYY-MM-DD, all if omitted)",
+	"flag.daily_export_output":    "Output file (standard output if omitted)",
+	"flag.history_character":      "Filter by character ID (ironclad, silent, ...)",
+	"flag.history_result":         "Filter by result (victory, defeat, abandoned)",
+	"flag.history_min_floor":      "Minimum floor reached",
+	"flag.history_seed":           "Filter by seed",
+	"flag.history_limit":          "Number of runs to show (0 for all)",
+	"flag.history_by_score":       "Sort by score, highest first",
+	"flag.profile_reset_yes":      "Reset without confirmation",
+	"usage.daily_import":          "Usage: cts daily import <file>",
+	"usage.history_show":          "Usage: cts history show <ID>",
+	"daily.title":                 "Daily challenge %s (seed: %d)",
+	"daily.empty":                 "No records yet",
+	"daily.row":                   "%2d. %s %s %5d  %s floor %d",
+	"daily.exported":              "Exported %s to %s",
+	"daily.imported":              "Imported %s",
+	"profile.experience":          "Experience: %d",
+	"profile.tiers":               "Unlocked tiers: %d",
+	"profile.next_tier":           "To the next tier: %d",
+	"profile.all_unlocked":        "Every tier is unlocked",
+	"profile.max_ascension":       "Max ascension: %d",
+	"profile.reset":               "The profile has been reset",
+	"error.prefix":                "Error: %v",
+	"error.date_format":           "Dates must be written as YYYY-MM-DD: %s",
+	"error.export_open":           "Could not open the output file",
+	"error.import_open":           "Could not open the file to import",
+	"error.id_not_number":         "The ID must be a number: %s",
+	"error.seed_not_number":       "The seed must be a number: %s",
+	"error.unknown_result":        "Unknown result: %s",
+	"error.profile_reset_confirm": "All experience and unlocks will be lost. Run again with --yes to confirm",
+	"error.history_load":          "Could not load the history: %v",
+	"error.history_save":          "Failed to save the history: %v",
+	"error.profile_save":          "Failed to save the profile: %v",
+	"error.leaderboard_save":      "Failed to record on the leaderboard: %v",
+	"error.setup":                 "Could not change the setting: %v",
+	"error.start_run":             "Could not start the run: %v",
+}
@@ -0,0 +1,564 @@
+package i18n
+
+// japanese は日本語のメッセージの一覧じゃ
+var japanese = catalog{
+	// 共通の表記
+	"number.separator":   ",",
+	"common.unknown":     "不明",
+	"common.gold_amount": "%sゴールド",
+	"count.cards":        "%d枚",
+	"intent.attack":      "攻撃",
+	"intent.defend":      "防御",
+	"intent.buff":        "強化",
+	"enemy.slime":        "スライム",
+	"enemy.jaw_worm":     "アゴムシ",
+
+	// カードの共通の表記
+	"card.rarity.basic":    "初期",
+	"card.rarity.common":   "コモン",
+	"card.rarity.uncommon": "アンコモン",
+	"card.rarity.rare":     "レア",
+	"card.type.attack":     "アタック",
+	"card.type.skill":      "スキル",
+	"card.type.power":      "パワー",
+	"card.type.curse":      "呪い",
+	"card.upgraded_name":   "%s+",
+	"card.text.draw":       "カードを%d枚引く",
+
+	// カード
+	"card.strike.name":                      "ストライク",
+	"card.strike.description":               "%dダメージを与える",
+	"card.defend.name":                      "ディフェンド",
+	"card.defend.description":               "%dブロックを得る",
+	"card.bash.name":                        "バッシュ",
+	"card.bash.description":                 "%dダメージを与え、%d脆弱を付与する",
+	"card.pommel_strike.name":               "ポンメルストライク",
+	"card.pommel_strike.description":        "%dダメージを与え、%s",
+	"card.shockwave.name":                   "衝撃波",
+	"card.shockwave.description":            "全ての敵に%d脆弱と%d弱体化を付与する。廃棄",
+	"card.inflame.name":                     "発火",
+	"card.inflame.description":              "筋力を%d得る",
+	"card.limit_break.name":                 "限界突破",
+	"card.limit_break.description":          "筋力を2倍にする。廃棄",
+	"card.demon_form.name":                  "悪魔化",
+	"card.demon_form.description":           "ターン開始時に筋力を%d得る",
+	"card.twin_strike.name":                 "ツインストライク",
+	"card.twin_strike.description":          "%dダメージを2回与える",
+	"card.iron_wave.name":                   "アイアンウェーブ",
+	"card.iron_wave.description":            "%dブロックを得て、%dダメージを与える",
+	"card.shrug_it_off.name":                "受け流し",
+	"card.shrug_it_off.description":         "%dブロックを得て、%s",
+	"card.body_slam.name":                   "ボディスラム",
+	"card.body_slam.description":            "現在のブロック値に等しいダメージを与える",
+	"card.metallicize.name":                 "金属化",
+	"card.metallicize.description":          "ターン終了時に%dブロックを得る",
+	"card.entrench.name":                    "塹壕",
+	"card.entrench.description":             "ブロック値を2倍にする",
+	"card.bludgeon.name":                    "ブラッジョン",
+	"card.bludgeon.description":             "%dダメージを与える",
+	"card.neutralize.name":                  "無力化",
+	"card.neutralize.description":           "%dダメージを与え、%d弱体化を付与する",
+	"card.survivor.name":                    "生存者",
+	"card.survivor.description":             "%dブロックを得る",
+	"card.quick_slash.name":                 "クイックスラッシュ",
+	"card.quick_slash.description":          "%dダメージを与え、%s",
+	"card.dagger_spray.name":                "ダガースプレー",
+	"card.dagger_spray.description":         "%dダメージを2回与える",
+	"card.backflip.name":                    "バックフリップ",
+	"card.backflip.description":             "%dブロックを得て、%s",
+	"card.leg_sweep.name":                   "足払い",
+	"card.leg_sweep.description":            "%d弱体化を付与し、%dブロックを得る",
+	"card.dash.name":                        "ダッシュ",
+	"card.dash.description":                 "%dブロックを得て、%dダメージを与える",
+	"card.adrenaline.name":                  "アドレナリン",
+	"card.adrenaline.description":           "エナジーを%d得て、%s。廃棄",
+	"card.die_die_die.name":                 "死ね死ね死ね",
+	"card.die_die_die.description":          "%dダメージを与える",
+	"card.swift_strike.name":                "スウィフトストライク",
+	"card.swift_strike.description":         "%dダメージを与える",
+	"card.good_instincts.name":              "直感",
+	"card.good_instincts.description":       "%dブロックを得る",
+	"card.injury.name":                      "負傷",
+	"card.injury.description":               "使用できない",
+	"card.limit_break.description_upgraded": "筋力を2倍にする",
+
+	// 用語集
+	"keyword.strength.name":          "筋力",
+	"keyword.strength.description":   "アタックで与えるダメージがその値だけ増える",
+	"keyword.dexterity.name":         "敏捷性",
+	"keyword.dexterity.description":  "カードで得るブロックがその値だけ増える",
+	"keyword.vulnerable.name":        "脆弱",
+	"keyword.vulnerable.description": "アタックで受けるダメージが50%増える。ターン終了時に1減る",
+	"keyword.weak.name":              "弱体化",
+	"keyword.weak.description":       "アタックで与えるダメージが25%減る。ターン終了時に1減る",
+	"keyword.block.name":             "ブロック",
+	"keyword.block.description":      "受けるダメージをその値だけ防ぐ",
+	"keyword.energy.name":            "エナジー",
+	"keyword.energy.description":     "カードを使うのに必要じゃ。ターン開始時に最大値まで戻る",
+	"keyword.exhaust.name":           "廃棄",
+	"keyword.exhaust.description":    "使うと廃棄札に移り、その戦闘の間は戻ってこない",
+	"keyword.unplayable.name":        "使用できない",
+	"keyword.unplayable.description": "手札から使うことができない",
+	"keyword.power.name":             "パワー",
+	"keyword.power.description":      "使うとその戦闘の間ずっと効果が続く",
+	"keyword.curse.name":             "呪い",
+	"keyword.curse.description":      "役に立たず、デッキの邪魔になるカード",
+
+	// レリック
+	"relic.burning_blood.name":            "燃える血",
+	"relic.burning_blood.description":     "戦闘終了時に体力を%d回復する",
+	"relic.anchor.name":                   "錨",
+	"relic.anchor.description":            "戦闘開始時に%dブロックを得る",
+	"relic.vajra.name":                    "金剛杵",
+	"relic.vajra.description":             "戦闘開始時に筋力を%d得る",
+	"relic.blood_vial.name":               "血の小瓶",
+	"relic.blood_vial.description":        "戦闘開始時に体力を%d回復する",
+	"relic.lantern.name":                  "ランタン",
+	"relic.lantern.description":           "戦闘開始時にエナジーを%d得る",
+	"relic.potion_belt.name":              "ポーションベルト",
+	"relic.potion_belt.description":       "ポーションの所持枠が%dつ増える",
+	"relic.ring_of_the_snake.name":        "蛇の指輪",
+	"relic.ring_of_the_snake.description": "戦闘開始時にカードを%d枚追加で引く",
+
+	// ポーション
+	"potion.fire_potion.name":            "火のポーション",
+	"potion.fire_potion.description":     "%dダメージを与える",
+	"potion.block_potion.name":           "ブロックポーション",
+	"potion.block_potion.description":    "%dブロックを得る",
+	"potion.strength_potion.name":        "筋力ポーション",
+	"potion.strength_potion.description": "筋力を%d得る",
+
+	// キャラクター
+	"character.ironclad.name":        "アイアンクラッド",
+	"character.ironclad.description": "悪魔の力を宿した戦士。高い体力と筋力で押し切るのじゃ",
+	"character.silent.name":          "サイレント",
+	"character.silent.description":   "霧の地から来た狩人。弱体化と手数で敵を翻弄するのじゃ",
+
+	// アセンション
+	"ascension.none":               "変化なし",
+	"ascension.more_elites":        "エリートが多く出現する",
+	"ascension.stronger_enemies":   "通常の敵の筋力が1増える",
+	"ascension.stronger_elites":    "エリートの筋力が1増える",
+	"ascension.stronger_boss":      "ボスの筋力が2増える",
+	"ascension.tougher_enemies":    "通常の敵の体力が10%増える",
+	"ascension.tougher_elites":     "エリートの体力が10%増える",
+	"ascension.tougher_boss":       "ボスの体力が10%増える",
+	"ascension.less_healing":       "休憩所での回復量が5%減る",
+	"ascension.injured_start":      "体力が減った状態で開始する",
+	"ascension.starting_curse":     "呪いを持って開始する",
+	"ascension.fewer_potion_slots": "ポーションの所持枠が1つ減る",
+	"ascension.less_gold":          "戦闘で得られるゴールドが20%減る",
+	"ascension.lower_max_health":   "最大体力が4減る",
+
+	// デイリーチャレンジの変化
+	"daily.random_rare":     "ランダムなレアカードを持って開始する",
+	"daily.double_elites":   "エリートが2倍出現する",
+	"daily.no_rest_sites":   "休憩所が出現しない",
+	"daily.expensive_cards": "カードのコストが1増える",
+
+	// マップのノード
+	"node.enemy":    "敵",
+	"node.elite":    "エリート",
+	"node.boss":     "ボス",
+	"node.rest":     "休憩",
+	"node.shop":     "店",
+	"node.treasure": "宝箱",
+	"node.event":    "イベント",
+
+	// ランの結果
+	"result.victory":   "勝利",
+	"result.defeat":    "敗北",
+	"result.abandoned": "放棄",
+
+	// 戦闘ログ
+	"combat.player":             "プレイヤー",
+	"log.combat_start":          "フロア%d %sとの戦闘",
+	"log.turn":                  "ターン%d",
+	"log.damage":                "%sに%dダメージ、体力-%d",
+	"log.damage_blocked":        "%sに%dダメージ、ブロックで全て防いだ",
+	"log.damage_partly_blocked": "%sに%dダメージ、ブロックで%d防いで体力-%d",
+	"log.block":                 "%sはブロックを%d得た (計%d)",
+	"log.heal":                  "%sは体力を%d回復した",
+	"log.strength":              "%sの筋力%+d (計%d)",
+	"log.strength_set":          "%sの筋力が%dになった",
+	"log.dexterity":             "%sの敏捷性%+d (計%d)",
+	"log.vulnerable":            "%sに脆弱%d (計%d)",
+	"log.weak":                  "%sに弱体化%d (計%d)",
+	"log.relic_triggered":       "レリック「%s」が発動した",
+	"log.power_gained":          "パワー「%s」を得た",
+	"log.power_triggered":       "パワー「%s」が発動した",
+	"log.enemy_move":            "%sの行動: %s",
+	"log.card_played":           "「%s」を使った (%dエナジー)",
+	"log.shuffle":               "捨て札%sをシャッフルして山札にした",
+	"log.draw":                  "カードを%s引いた",
+	"log.potion":                "ポーション「%s」を使った",
+	"log.enemy_defeated":        "%sを倒した",
+	"log.player_defeated":       "%sに倒された",
+	"enemy.boss":                "超%s",
+	"log.export_header":         "シード: %d\nキャラクター: %s\nアセンション: %d\n\n",
+
+	// スコアの内訳
+	"score.floors":          "フロア踏破",
+	"score.enemies":         "敵の撃破",
+	"score.elites":          "エリートの撃破",
+	"score.bosses":          "ボスの撃破",
+	"score.perfect_fights":  "完璧な戦闘",
+	"score.gold":            "ゴールド",
+	"score.big_deck":        "大所帯",
+	"score.big_deck_detail": "デッキ%s",
+	"score.rares":           "レア収集家",
+	"score.rares_detail":    "レア%s",
+	"score.powers":          "パワー全開",
+	"score.powers_detail":   "パワー%s",
+	"score.curses":          "呪い",
+
+	// エラー
+	"error.no_combat_log_store": "戦闘ログの書き出し先が無いのじゃ",
+	"error.run_not_found":       "ID %d のランは見つからないのじゃ",
+	"error.unknown_character":   "キャラクター%sは存在しないのじゃ",
+	"error.character_locked":    "キャラクター%sはまだ解放されておらんのじゃ",
+	"error.ascension_locked":    "アセンション%dはまだ解放されておらんのじゃ (最大: %d)",
+	"error.no_saved_run":        "中断したランはないのじゃ",
+	"error.daily_date":          "デイリーチャレンジの日付の読み込みに失敗じゃ",
+	"error.saved_map_position":  "セーブデータのマップの位置が正しくないのじゃ",
+
+	// 保存先と画面の初期化のエラー
+	"error.theme_open":            "テーマファイルを開けなかったのじゃ",
+	"error.theme_broken":          "テーマファイルが壊れておるのじゃ",
+	"error.unknown_theme":         "テーマ%sは存在しないのじゃ (選べるテーマ: %v)",
+	"error.unknown_theme_color":   "テーマファイルの色%sは存在しないのじゃ",
+	"error.unknown_theme_style":   "テーマファイルのスタイル%sは存在しないのじゃ",
+	"error.screen_init":           "スクリーンの初期化に失敗じゃ",
+	"error.save_open":             "セーブデータを開けなかったのじゃ",
+	"error.save_broken":           "セーブデータが壊れておるのじゃ",
+	"error.save_encode":           "セーブデータの変換に失敗じゃ",
+	"error.save_delete":           "セーブデータの削除に失敗じゃ",
+	"error.history_encode":        "ランの記録の変換に失敗じゃ",
+	"error.history_dir":           "履歴ディレクトリの作成に失敗じゃ",
+	"error.history_open":          "履歴ファイルを開けなかったのじゃ",
+	"error.history_write":         "履歴ファイルへの書き込みに失敗じゃ",
+	"error.history_broken_line":   "履歴ファイルの%d行目が壊れておるのじゃ",
+	"error.history_read":          "履歴ファイルの読み込みに失敗じゃ",
+	"error.keymap_open":           "キーマップを開けなかったのじゃ",
+	"error.keymap_broken":         "キーマップが壊れておるのじゃ",
+	"error.unknown_keymap":        "キーマップ%sは存在しないのじゃ (選べるキーマップ: %v)",
+	"error.unknown_keymap_action": "キーマップの操作%sは存在しないのじゃ",
+	"error.leaderboard_encode":    "リーダーボードの変換に失敗じゃ",
+	"error.leaderboard_open":      "リーダーボードを開けなかったのじゃ",
+	"error.leaderboard_export":    "リーダーボードの書き出しに失敗じゃ",
+	"error.leaderboard_broken":    "リーダーボードが壊れておるのじゃ",
+	"error.combat_log_export":     "戦闘ログの書き出しに失敗じゃ",
+	"error.home_dir":              "ホームディレクトリの取得に失敗じゃ",
+	"error.make_dir":              "ディレクトリの作成に失敗じゃ",
+	"error.file_write":            "ファイルの書き込みに失敗じゃ",
+	"error.file_replace":          "ファイルの置き換えに失敗じゃ",
+	"error.profile_open":          "プロフィールを開けなかったのじゃ",
+	"error.profile_broken":        "プロフィールが壊れておるのじゃ",
+	"error.profile_encode":        "プロフィールの変換に失敗じゃ",
+	"error.settings_open":         "設定ファイルを開けなかったのじゃ",
+	"error.settings_broken":       "設定ファイルが壊れておるのじゃ",
+	"error.settings_encode":       "設定の変換に失敗じゃ",
+	"error.unknown_frame_rate":    "描画の上限%dは選べないのじゃ %v",
+	"error.unknown_log_verbosity": "戦闘ログの細かさ%sは無いのじゃ",
+	"error.unknown_lang":          "言語%sには対応していないのじゃ %v",
+
+	// 戦闘ログの欄
+	"log.title":          " ログ %s ",
+	"log.title_scrolled": " ログ %s ↓%d ",
+
+	// 図鑑
+	"compendium.title":           "図鑑",
+	"compendium.cards_red":       "カード（アイアンクラッド）",
+	"compendium.cards_green":     "カード（サイレント）",
+	"compendium.cards_colorless": "カード（無色）",
+	"compendium.relics":          "レリック",
+	"compendium.potions":         "ポーション",
+	"compendium.locked":          "  ？？？ (未解放)",
+	"card.name_with_cost":        "%s (%dエナジー)",
+	"error.profile_load":         "プロフィールを読み込めなかったのじゃ: %v",
+	"help.compendium":            "操作: %s:選択 %s:詳細 %s:戻る",
+
+	// 詳細の表示
+	"inspect.title":            " 詳細 (%d/%d) ",
+	"inspect.card_kind":        "%s / %s / %dエナジー",
+	"inspect.cost":             "コスト: %d → %d",
+	"inspect.damage":           "ダメージ",
+	"inspect.block":            "ブロック",
+	"inspect.intention":        "意図: %s",
+	"inspect.intention_damage": "意図: %s %dダメージ",
+	"inspect.enemy":            "敵",
+	"inspect.enemy_health":     "体力: %d/%d  ブロック: %d",
+	"inspect.buff":             "強化",
+	"inspect.debuff":           "弱体",
+	"inspect.status_kind":      "%s / %s",
+	"inspect.permanent":        "戦闘の終わりまで続く",
+	"inspect.stacks":           "重ねた数: %d",
+	"inspect.turns_left":       "残り%dターン",
+	"inspect.relic":            "レリック",
+	"inspect.potion":           "ポーション",
+	"inspect.numbers":          "現在の数値:",
+	"inspect.upgrade":          "アップグレード後:",
+	"inspect.keywords":         "用語:",
+	"help.inspect":             " %s:切り替え %s:閉じる ",
+
+	// カードの束と手札
+	"combat.block":            "ブロック%d",
+	"hand.more_left":          "◀ %s",
+	"hand.more_right":         "%s ▶",
+	"pile.deck":               "デッキ",
+	"pile.draw":               "山札",
+	"pile.discard":            "捨て札",
+	"pile.exhaust":            "廃棄札",
+	"pile.sort_cost":          "コスト順",
+	"pile.sort_type":          "種類順",
+	"pile.sort_rarity":        "レア度順",
+	"pile.sort_name":          "名前順",
+	"pile.title":              " %s (%s) %s ",
+	"pile.empty":              "カードはありません",
+	"help.pile":               " %s:選択 %s:詳細 %s:並べ替え %s:デッキ ",
+	"help.pile_combat":        " %s:選択 %s:詳細 %s:並べ替え %s:デッキ %s:山札 %s:捨て札 %s:廃棄札 ",
+	"animation.normal":        "通常",
+	"animation.fast":          "速い",
+	"animation.off":           "なし",
+	"animation.block":         "ブロック%+d",
+	"error.unknown_animation": "アニメーションの速さ%sは無いのじゃ %v",
+	"keymap.conflict":         "%sが%sと%sに割り当てられておる",
+	"error.keymap_conflict":   "キーの割り当てが重なっておるのじゃ: %s",
+
+	// 画面
+	"header.daily":                   "デイリー %s",
+	"header.ascension":               "アセンション %d",
+	"size.warning":                   "画面サイズが小さすぎる！",
+	"size.current":                   "現在: %dx%d 最小: %dx%d",
+	"menu.continue":                  "続きから",
+	"menu.new_run":                   "新しいラン",
+	"menu.setup":                     "キャラクター・アセンション選択",
+	"menu.compendium":                "図鑑",
+	"menu.history":                   "ラン履歴",
+	"menu.settings":                  "設定",
+	"menu.quit":                      "終了",
+	"menu.setup_summary":             "キャラクター: %s  アセンション: %d",
+	"setup.title":                    "キャラクター・アセンション選択",
+	"setup.choose_later":             "ラン開始時に選ぶ",
+	"setup.choose_later_description": "ランを始めるときにキャラクター選択画面を出すのじゃ",
+	"setup.character":                "キャラクター: < %s >",
+	"setup.ascension":                "アセンション: < %d > (最大: %d)",
+	"pause.title":                    "ポーズ",
+	"pause.resume":                   "ランに戻る",
+	"pause.settings":                 "設定",
+	"pause.save_quit":                "保存してメニューに戻る",
+	"pause.abandon":                  "ランを放棄する",
+	"pause.abandon_title":            "ランを放棄するかの？",
+	"pause.abandon_warning":          "放棄したランは再開できないのじゃ",
+	"pause.abandon_no":               "やめる",
+	"pause.abandon_yes":              "放棄する",
+	"settings.title":                 "設定",
+	"settings.animation":             "アニメーション: < %s >",
+	"settings.language":              "言語: < %s >",
+	"settings.theme":                 "テーマ: < %s >",
+	"settings.frame_rate":            "描画の上限: < %s >",
+	"settings.fps":                   "%dFPS",
+	"settings.keymap":                "キーマップ: < %s >",
+	"settings.confirm_end_turn":      "ターン終了の確認: < %s >",
+	"settings.fast_enemy_turns":      "敵のターンを早送り: < %s >",
+	"settings.damage_preview":        "ダメージの予測: < %s >",
+	"settings.log_verbosity":         "戦闘ログ: < %s >",
+	"settings.auto":                  "自動",
+	"settings.on":                    "オン",
+	"settings.off":                   "オフ",
+	"log_verbosity.all":              "全て",
+	"log_verbosity.normal":           "標準",
+	"log_verbosity.brief":            "簡潔",
+	"error.settings_apply":           "設定を変えられなかったのじゃ: %v",
+	"combat.energy":                  "エナジー: %d/%d",
+	"combat.gold":                    "ゴールド: %s",
+	"combat.intent":                  "意図: %s %d",
+	"combat.target_hint":             "敵をクリックしてカードを使う",
+	"combat.piles":                   "%s:山札 %s %s:捨て札 %s %s:廃棄札 %s %s:デッキ",
+	"combat.end_turn_button":         "[ターン終了]",
+	"end_turn_prompt.title":          "ターン終了",
+	"end_turn_prompt.body":           "まだエナジーが%d残っていて、使えるカードがあるのじゃ。ターンを終えるかの？",
+	"combat.potions":                 "ポーション (%d/%d):",
+	"combat.potion":                  "[%s: %s]",
+	"map.title":                      "ダンジョンマップ",
+	"map.floor":                      "現在のフロア: %d",
+	"map.choices":                    "選択可能なノード:",
+	"map.node":                       "%s (フロア %d)",
+	"map.modifiers":                  "変化: %s",
+	"map.player":                     "体力: %d/%d  ゴールド: %s",
+	"rest.title":                     "休憩所",
+	"rest.heal":                      "回復 (体力の%d%%回復)",
+	"rest.upgrade":                   "カードアップグレード (未実装)",
+	"rest.player":                    "体力: %d/%d",
+	"character_select.title":         "キャラクター選択",
+	"character_select.row":           "%s  体力:%d  所持金:%s",
+	"character_select.locked":        "%s  (未解放)",
+	"character_select.starter_relic": "初期レリック: %s - %s",
+	"shop.title":                     "ショップ",
+	"shop.price":                     "%3dゴールド",
+	"shop.sold_out":                  "売り切れ",
+	"shop.gold":                      "所持金: %sゴールド",
+	"event.title":                    "イベント",
+	"event.not_implemented":          "イベント機能はまだ実装されていません",
+	"event.continue":                 "何かキーを押してマップに戻る...",
+	"reward.victory":                 "敵を倒した！",
+	"reward.gold":                    "%sゴールド",
+	"reward.potion":                  "ポーション: %s",
+	"reward.relic":                   "レリック: %s - %s",
+	"reward.card_reward":             "カードを1枚デッキに加える",
+	"reward.proceed":                 "先に進む",
+	"reward.cards":                   "カード報酬",
+	"reward.card":                    "%s (%dエナジー) - %s",
+	"reward.potion_full":             "ポーションの所持枠がいっぱいなのじゃ",
+	"reward.leave_title":             "報酬を残して進むかの？",
+	"reward.leave_warning":           "受け取っていない報酬が%d個残っておるのじゃ",
+	"reward.leave_no":                "やめる",
+	"reward.leave_yes":               "残して進む",
+	"gameover.title":                 "ゲームオーバー",
+	"gameover.victory":               "勝利！",
+	"gameover.score":                 "スコア内訳:",
+	"gameover.total":                 "合計",
+	"gameover.continue":              "何かキーを押してメニューに戻る...",
+	"gameover.log_exported":          "戦闘ログを書き出したのじゃ: %s",
+	"gameover.ascension_unlocked":    "アセンション%dが解放された！ %s",
+	"gameover.new_unlocks":           "新たに解放: %s",
+	"unlocks.title":                  "解放状況",
+	"unlocks.experience":             "経験値: %d",
+	"unlocks.next_tier":              "次の段階まで %s %d/%d",
+	"unlocks.all_unlocked":           "全ての段階を解放済みじゃ！",
+	"unlocks.locked":                 "未解放",
+	"unlocks.unlocked":               "解放済み",
+	"unlocks.tier":                   "段階%d (経験値 %d) %s",
+	"unlocks.card":                   "カード:%s",
+	"unlocks.relic":                  "レリック:%s",
+	"unlocks.character":              "キャラクター:%s",
+	"history.title":                  "ラン履歴",
+	"history.empty":                  "記録されたランはありません",
+	"history.row":                    "#%-4d %s  %s  フロア %-2d %s  スコア %d",
+	"history.summary":                "#%-4d %s  %-4s フロア %-2d %s A%d  %dゴールド  スコア %d",
+	"history.run":                    "ラン #%d  %s",
+	"history.run_id":                 "ラン #%d",
+	"history.date":                   "日時: %s",
+	"history.seed":                   "シード: %d",
+	"history.character":              "キャラクター: %s  アセンション: %d",
+	"history.character_only":         "キャラクター: %s",
+	"history.ascension":              "アセンション: %d",
+	"history.result":                 "結果: %s  到達フロア: %d",
+	"history.result_only":            "結果: %s",
+	"history.floor":                  "到達フロア: %d",
+	"history.killed_by":              "倒された相手: %s",
+	"history.gold":                   "ゴールド: %s  プレイ時間: %s",
+	"history.gold_only":              "ゴールド: %s",
+	"history.duration":               "プレイ時間: %s",
+	"history.score":                  "スコア: %s",
+	"history.deck":                   "デッキ (%s): %s",
+	"history.relics":                 "レリック: %s",
+	"history.none":                   "なし",
+
+	// 操作説明
+	"help.menu":             "操作: %s:選択 %s:決定 %s:終了",
+	"help.setup":            "操作: %s:選択 %s:変更 %s:決定 %s:戻る",
+	"help.end_turn_prompt":  "操作: %s:ターン終了 他のキー:戻る",
+	"help.pause":            "操作: %s:選択 %s:決定 %s:戻る",
+	"help.settings":         "操作: %s:選択 %s:変更 %s:戻る",
+	"help.history":          "操作: %s:選択 %s:詳細 %s:戻る",
+	"help.overlay":          "操作: %s:戻る",
+	"help.combat":           "%s:選択 %s:決定 %s-%s:カード %s:ターン終了 %s:ポーション %s:詳細 %s:メニュー",
+	"help.map":              "操作: %s:選択 %s:決定 %s:デッキ %s:詳細 %s:メニュー",
+	"help.character_select": "操作: %s:選択 %s:決定 %s:メニュー",
+	"help.shop":             "操作: %s:選択 %s:購入 %s:店を出る %s:詳細 %s:メニュー",
+	"help.reward":           "%s:選択 %s:受け取る %s:捨てる %s:デッキ %s:詳細 %s:メニュー",
+	"help.reward_cards":     "%s:選択 %s:決定 %s:このカード報酬を捨てる %s:詳細 %s:戻る",
+	"help.gameover":         "%s: ラン履歴を見る %s: 解放状況を見る %s: 戦闘ログを書き出す",
+
+	// 行単位の遊び方
+	"error.command_unknown":    "%s という命令は無いのじゃ。help で命令の一覧を見られるのじゃ",
+	"error.command_number":     "%s には番号を付けるのじゃ",
+	"error.command_not_number": "番号は1以上の数字で指定するのじゃ: %s",
+	"error.command_extra":      "余分な言葉があるのじゃ: %s",
+	"line.confirm_end_turn":    "まだエナジーが%d残っていて、使えるカードがあるのじゃ。もう一度 end と打てばターンを終えるのじゃ",
+	"line.not_here":            "ここではその命令は使えないのじゃ",
+	"line.no_card":             "%d枚目のカードは手札に無いのじゃ",
+	"line.cannot_play":         "%sは今は使えないのじゃ",
+	"line.no_potion":           "%d番目のポーションは無いのじゃ",
+	"line.no_enemy":            "%d番目の敵はいないのじゃ",
+	"line.no_choice":           "%d番の選択肢は無いのじゃ",
+	"line.cannot_buy":          "%sは買えないのじゃ",
+	"line.locked":              "%sはまだ解放されていないのじゃ",
+	"line.menu":                "メインメニュー",
+	"line.enemy":               "敵%d: %s  体力 %d/%d  ブロック %d  %s",
+	"line.player":              "あなた: 体力 %d/%d  ブロック %d  エナジー %d/%d",
+	"line.statuses":            "  状態: %s",
+	"line.hand":                "手札:",
+	"line.card":                "%d. %s (コスト %s) %s",
+	"line.unplayable":          "[使えない]",
+	"line.piles":               "山札 %s、捨て札 %s、廃棄札 %s",
+	"line.pile_card":           "%s (コスト %d) %s",
+	"line.hint.pick":           "番号を打ち込んで選ぶ。help で命令の一覧",
+	"line.hint.map":            "map 番号 で進む。deck でデッキを見る",
+	"line.hint.combat":         "play 番号 でカードを使う。potion 番号 でポーション、end でターン終了",
+	"line.hint.reward":         "番号で報酬を受け取る。skip で先に進む",
+	"line.hint.reward_cards":   "番号でカードを選ぶ。skip でこのカード報酬を捨てる",
+	"line.hint.shop":           "番号で買う。leave で店を出る",
+	"line.hint.continue":       "skip で先に進む",
+	"line.help.title":          "命令の一覧:",
+	"line.help.look":           "look: 今の様子をもう一度聞く",
+	"line.help.play":           "play 番号 [on 敵]: 手札のカードを使う",
+	"line.help.potion":         "potion 番号 [on 敵]: ポーションを使う",
+	"line.help.end":            "end: ターンを終える",
+	"line.help.map":            "map 番号: マップで次に進むノードを選ぶ",
+	"line.help.pick":           "pick 番号、または番号だけ: 選択肢を選ぶ",
+	"line.help.skip":           "skip, leave: 報酬を飛ばす、店を出る、先に進む",
+	"line.help.piles":          "deck, draw, discard, exhaust: カードの束の中身を聞く",
+	"line.help.quit":           "quit: ランの途中ではポーズメニューを開き、もう一度打つとランに戻る。メニューではゲームを終了する",
+
+	// コマンドライン
+	"count.records":               "%d件",
+	"flag.ascension":              "新しいランのアセンションの段階 (0-20)",
+	"flag.character":              "新しいランで使うキャラクターのID (省略するとラン開始時に選ぶ)",
+	"flag.daily":                  "メニューを通さずに今日のデイリーチャレンジを遊ぶ",
+	"flag.name":                   "リーダーボードに載せる名前",
+	"flag.theme":                  "画面の配色 %v (省略するとテーマファイルかNO_COLORに従う)",
+	"flag.keymap":                 "キーの割り当て %v (省略するとキーマップのファイルに従う)",
+	"flag.fps":                    "1秒あたりに描画する回数の上限 %v",
+	"flag.animation":              "戦闘のアニメーションの速さ %v",
+	"flag.lang":                   "表示する言語 %v (省略するとLANGに従う)",
+	"flag.line":                   "1行ずつ命令を打ち込んで遊ぶ (読み上げソフトやスクリプト向け)",
+	"flag.daily_date":             "表示する日付 (YYYY-MM-DD, 省略すると今日)",
+	"flag.daily_export_date":      "書き出す日付 (YYYY-MM-DD, 省略すると全て)",
+	"flag.daily_export_output":    "書き出し先のファイル (省略すると標準出力)",
+	"flag.history_character":      "キャラクターのIDで絞り込む (ironclad、silentなど)",
+	"flag.history_result":         "結果で絞り込む (victory, defeat, abandoned)",
+	"flag.history_min_floor":      "到達フロアの下限",
+	"flag.history_seed":           "シードで絞り込む",
+	"flag.history_limit":          "表示する件数 (0で全件)",
+	"flag.history_by_score":       "スコアの高い順に並べる",
+	"flag.profile_reset_yes":      "確認せずにリセットする",
+	"usage.daily_import":          "使い方: cts daily import <ファイル>",
+	"usage.history_show":          "使い方: cts history show <ID>",
+	"daily.title":                 "デイリーチャレンジ %s (シード: %d)",
+	"daily.empty":                 "まだ記録はありません",
+	"daily.row":                   "%2d. %s %s %5d  %s フロア %d",
+	"daily.exported":              "%sの記録を %s に書き出しました",
+	"daily.imported":              "%sの記録を取り込みました",
+	"profile.experience":          "経験値: %d",
+	"profile.tiers":               "解放段階: %d",
+	"profile.next_tier":           "次の段階まで: %d",
+	"profile.all_unlocked":        "全ての段階を解放済み",
+	"profile.max_ascension":       "最大アセンション: %d",
+	"profile.reset":               "プロフィールをリセットしました",
+	"error.prefix":                "エラー: %v",
+	"error.date_format":           "日付は YYYY-MM-DD の形で指定するのじゃ: %s",
+	"error.export_open":           "書き出し先を開けなかったのじゃ",
+	"error.import_open":           "取り込むファイルを開けなかったのじゃ",
+	"error.id_not_number":         "IDは数字で指定するのじゃ: %s",
+	"error.seed_not_number":       "シードは数字で指定するのじゃ: %s",
+	"error.unknown_result":        "不明な結果じゃ: %s",
+	"error.profile_reset_confirm": "経験値と解放状況が全て失われるのじゃ。よければ --yes を付けて実行するのじゃ",
+	"error.history_load":          "履歴を読み込めなかったのじゃ: %v",
+	"error.history_save":          "履歴の保存に失敗じゃ: %v",
+	"error.profile_save":          "プロフィールの保存に失敗じゃ: %v",
+	"error.leaderboard_save":      "リーダーボードへの記録に失敗じゃ: %v",
+	"error.setup":                 "設定を変えられなかったのじゃ: %v",
+	"error.start_run":             "ランを始められなかったのじゃ: %v",
+}
@@ -0,0 +1,201 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Lang は画面に表示する言語じゃ
+type Lang string
+
+// 対応している言語の定義
+const (
+	Japanese Lang = "ja"
+	English  Lang = "en"
+)
+
+// DefaultLang は何も指定しなかったときの言語じゃ
+const DefaultLang = Japanese
+
+// catalog はメッセージのキーから表示する書式を引く表じゃ
+// 書式はfmt.Sprintfの書式で、語順の違う言語では %[2]s のように引数の位置を指定するのじゃ
+// 数によって形の変わるメッセージは キー.one や キー.other のように形ごとに登録するのじゃ
+type catalog map[string]string
+
+// catalogs は言語ごとのメッセージの一覧じゃ
+var catalogs = map[Lang]catalog{
+	Japanese: japanese,
+	English:  english,
+}
+
+// langLabels は言語の選択で表示する言語の名前じゃ。どの言語でもその言語自身の名前で表示するのじゃ
+var langLabels = map[Lang]string{
+	Japanese: "日本語",
+	English:  "English",
+}
+
+// current は今の言語じゃ
+// 切り替えるのも読むのもゲームループと同じゴルーチンだけで、入力を待つゴルーチンはi18nを呼ばないので、ロックは要らないのじゃ
+var current = DefaultLang
+
+// Label は言語の名前を返すのじゃ
+func (l Lang) Label() string {
+	return langLabels[l]
+}
+
+// Langs は対応している言語を返すのじゃ
+func Langs() []Lang {
+	return []Lang{Japanese, English}
+}
+
+// LangNames は対応している言語の名前を返すのじゃ
+func LangNames() []string {
+	names := make([]string, 0, len(catalogs))
+	for lang := range catalogs {
+		names = append(names, string(lang))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseLang は名前から言語を求めるのじゃ
+// ja_JP.UTF-8 や en-US のようなロケールの名前も、先頭の言語の部分だけを見て受け付けるのじゃ
+func ParseLang(name string) (Lang, error) {
+	base := strings.ToLower(name)
+	if i := strings.IndexAny(base, "_-.@"); i >= 0 {
+		base = base[:i]
+	}
+	if _, ok := catalogs[Lang(base)]; ok {
+		return Lang(base), nil
+	}
+	return DefaultLang, errors.New(T("error.unknown_lang", name, LangNames()))
+}
+
+// DetectLang は環境変数から言語を決めるのじゃ
+// LC_ALL、LC_MESSAGES、LANG の順に見て、対応していなければ既定の言語にするのじゃ
+func DetectLang(getenv func(string) string) Lang {
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := getenv(key)
+		if value == "" {
+			continue
+		}
+		// 最初に見つかった指定に従い、対応していなければそれより後は見ないのじゃ
+		lang, err := ParseLang(value)
+		if err != nil {
+			return DefaultLang
+		}
+		return lang
+	}
+	return DefaultLang
+}
+
+// SetLang は表示する言語を切り替えるのじゃ
+func SetLang(lang Lang) {
+	current = lang
+}
+
+// CurrentLang は今の言語を返すのじゃ
+func CurrentLang() Lang {
+	return current
+}
+
+// lookup はキーに対応する書式を今の言語から引くのじゃ
+// 今の言語に無ければ日本語から引き、それでも無ければキーをそのまま返すのじゃ
+func lookup(key string) (string, bool) {
+	if format, ok := catalogs[CurrentLang()][key]; ok {
+		return format, true
+	}
+	format, ok := catalogs[DefaultLang][key]
+	return format, ok
+}
+
+// T はキーに対応するメッセージを今の言語で作るのじゃ
+// 引数にMessageを渡すと、それも今の言語の文字列にしてから埋め込むのじゃ
+func T(key string, args ...any) string {
+	format, ok := lookup(key)
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// N は数によって形の変わるメッセージを作るのじゃ
+// 数は書式の最初の引数として渡し、その後に残りの引数を続けるのじゃ
+func N(key string, count int, args ...any) string {
+	args = append([]any{count}, args...)
+	form := key + "." + pluralForm(CurrentLang(), count)
+	if _, ok := lookup(form); ok {
+		return T(form, args...)
+	}
+	if _, ok := lookup(key + ".other"); ok {
+		return T(key+".other", args...)
+	}
+	return T(key, args...)
+}
+
+// pluralForm は言語ごとの数の形を返すのじゃ
+// 日本語は数で形が変わらないので、いつもotherじゃ
+func pluralForm(lang Lang, count int) string {
+	if lang == English && (count == 1 || count == -1) {
+		return "one"
+	}
+	return "other"
+}
+
+// Number は数を3桁ごとに区切った文字列にするのじゃ
+func Number(n int) string {
+	digits := fmt.Sprintf("%d", n)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	separator := T("number.separator")
+	var b strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteString(separator)
+		}
+		b.WriteRune(digit)
+	}
+	return sign + b.String()
+}
+
+// Message は表示する言語が決まる前のメッセージじゃ
+// ドメインのエンティティは表示する文字列の代わりにこれを持ち、表示するときに今の言語の文字列にするのじゃ
+type Message struct {
+	Key    string
+	Args   []any
+	Plural bool // trueなら最初の引数の数によってメッセージの形を変えるのじゃ
+}
+
+// Msg はキーと引数からメッセージを作るのじゃ
+func Msg(key string, args ...any) Message {
+	return Message{Key: key, Args: args}
+}
+
+// Plural は数によって形の変わるメッセージを作るのじゃ
+func Plural(key string, count int, args ...any) Message {
+	return Message{Key: key, Args: append([]any{count}, args...), Plural: true}
+}
+
+// String はメッセージを今の言語の文字列にするのじゃ
+// 空のメッセージは空の文字列になるのじゃ
+func (m Message) String() string {
+	if m.Key == "" {
+		return ""
+	}
+	if m.Plural {
+		return N(m.Key, m.Args[0].(int), m.Args[1:]...)
+	}
+	return T(m.Key, m.Args...)
+}
+
+// IsZero はメッセージが空かどうかを返すのじゃ
+func (m Message) IsZero() bool {
+	return m.Key == ""
+}
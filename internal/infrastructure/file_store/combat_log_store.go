@@ -0,0 +1,29 @@
+package file_store
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// CombatLogStore は戦闘ログをテキストファイルに書き出すのじゃ
+// ランごとに別のファイルにして、前のログを上書きしないのじゃ
+type CombatLogStore struct {
+	dir string
+}
+
+// NewCombatLogStore はCombatLogStoreのインスタンスを生成するのじゃ
+func NewCombatLogStore(dir string) *CombatLogStore {
+	return &CombatLogStore{dir: filepath.Join(dir, "logs")}
+}
+
+// Export は戦闘ログを書き出した日時の名前のファイルに書き出すのじゃ
+func (s *CombatLogStore) Export(text string) (string, error) {
+	path := filepath.Join(s.dir, fmt.Sprintf("combat-%s.txt", time.Now().Format("20060102-150405")))
+	if err := writeFileAtomic(path, []byte(text)); err != nil {
+		return "", fmt.Errorf("%s: %w", i18n.T("error.combat_log_export"), err)
+	}
+	return path, nil
+}
@@ -0,0 +1,53 @@
+package file_store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// DataDir はctsのデータを保存するディレクトリを返すのじゃ
+// $XDG_DATA_HOME が無ければ ~/.local/share を使うのじゃ
+func DataDir() (string, error) {
+	base := os.Getenv("XDG_DATA_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", i18n.T("error.home_dir"), err)
+		}
+		base = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(base, "cts"), nil
+}
+
+// ConfigDir はctsの設定を保存するディレクトリを返すのじゃ
+// $XDG_CONFIG_HOME が無ければ ~/.config を使うのじゃ
+func ConfigDir() (string, error) {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", i18n.T("error.home_dir"), err)
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, "cts"), nil
+}
+
+// writeFileAtomic は一時ファイルに書いてから置き換えることで、途中で壊れないようにファイルを書き込むのじゃ
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.make_dir"), err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.file_write"), err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.file_replace"), err)
+	}
+	return nil
+}
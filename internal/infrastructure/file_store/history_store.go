@@ -0,0 +1,187 @@
+package file_store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// HistoryStore はラン履歴をJSON Lines形式のファイルに保存するのじゃ
+type HistoryStore struct {
+	path string
+}
+
+// runRecordJSON はファイルに書き出すランの記録の形式じゃ
+type runRecordJSON struct {
+	ID         int             `json:"id"`
+	Seed       int64           `json:"seed"`
+	Character  string          `json:"character"`
+	Ascension  int             `json:"ascension"`
+	Daily      string          `json:"daily,omitempty"`
+	Floor      int             `json:"floor"`
+	Result     string          `json:"result"`
+	KilledBy   string          `json:"killed_by,omitempty"`
+	Deck       []string        `json:"deck"`
+	Relics     []string        `json:"relics"`
+	Gold       int             `json:"gold"`
+	Score      int             `json:"score"`
+	ScoreItems []scoreItemJSON `json:"score_items,omitempty"`
+	DurationMs int64           `json:"duration_ms"`
+	Timestamp  time.Time       `json:"timestamp"`
+}
+
+// scoreItemJSON はファイルに書き出すスコアの内訳の形式じゃ
+type scoreItemJSON struct {
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"`
+	Points int    `json:"points"`
+}
+
+// ランの結果とファイル上の表記の対応じゃ
+var runResultNames = map[entities.RunResult]string{
+	entities.ResultVictory:   "victory",
+	entities.ResultDefeat:    "defeat",
+	entities.ResultAbandoned: "abandoned",
+}
+
+// NewHistoryStore はHistoryStoreのインスタンスを生成するのじゃ
+func NewHistoryStore(dir string) *HistoryStore {
+	return &HistoryStore{path: filepath.Join(dir, "history.jsonl")}
+}
+
+// Append はランの記録を履歴ファイルの末尾に追加するのじゃ
+func (s *HistoryStore) Append(record entities.RunRecord) (int, error) {
+	records, err := s.FindAll()
+	if err != nil {
+		return 0, err
+	}
+	record.ID = 1
+	if len(records) > 0 {
+		record.ID = records[len(records)-1].ID + 1
+	}
+
+	line, err := json.Marshal(toRunRecordJSON(record))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", i18n.T("error.history_encode"), err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return 0, fmt.Errorf("%s: %w", i18n.T("error.history_dir"), err)
+	}
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", i18n.T("error.history_open"), err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return 0, fmt.Errorf("%s: %w", i18n.T("error.history_write"), err)
+	}
+
+	return record.ID, nil
+}
+
+// FindAll は履歴ファイルから全てのランを読み込むのじゃ
+func (s *HistoryStore) FindAll() ([]entities.RunRecord, error) {
+	file, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []entities.RunRecord{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.history_open"), err)
+	}
+	defer file.Close()
+
+	records := []entities.RunRecord{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var raw runRecordJSON
+		if err := json.Unmarshal(scanner.Bytes(), &raw); err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T("error.history_broken_line", lineNo), err)
+		}
+		records = append(records, raw.toRunRecord())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.history_read"), err)
+	}
+
+	return records, nil
+}
+
+// toRunRecordJSON はランの記録をファイル形式に変換するのじゃ
+func toRunRecordJSON(record entities.RunRecord) runRecordJSON {
+	items := make([]scoreItemJSON, 0, len(record.ScoreItems))
+	for _, item := range record.ScoreItems {
+		items = append(items, scoreItemJSON{Name: item.Name, Detail: item.Detail, Points: item.Points})
+	}
+
+	return runRecordJSON{
+		ID:         record.ID,
+		Seed:       record.Seed,
+		Character:  record.Character,
+		Ascension:  record.Ascension,
+		Daily:      record.Daily,
+		Floor:      record.Floor,
+		Result:     runResultNames[record.Result],
+		KilledBy:   record.KilledBy,
+		Deck:       record.Deck,
+		Relics:     record.Relics,
+		Gold:       record.Gold,
+		Score:      record.Score,
+		ScoreItems: items,
+		DurationMs: record.Duration.Milliseconds(),
+		Timestamp:  record.Timestamp,
+	}
+}
+
+// toRunRecord はファイル形式からランの記録に戻すのじゃ
+func (r runRecordJSON) toRunRecord() entities.RunRecord {
+	result, ok := parseRunResult(r.Result)
+	if !ok {
+		result = entities.ResultAbandoned
+	}
+
+	items := make([]entities.ScoreItem, 0, len(r.ScoreItems))
+	for _, item := range r.ScoreItems {
+		items = append(items, entities.ScoreItem{Name: item.Name, Detail: item.Detail, Points: item.Points})
+	}
+
+	return entities.RunRecord{
+		ID:         r.ID,
+		Seed:       r.Seed,
+		Character:  r.Character,
+		Ascension:  r.Ascension,
+		Daily:      r.Daily,
+		Floor:      r.Floor,
+		Result:     result,
+		KilledBy:   r.KilledBy,
+		Deck:       r.Deck,
+		Relics:     r.Relics,
+		Gold:       r.Gold,
+		Score:      r.Score,
+		ScoreItems: items,
+		Duration:   time.Duration(r.DurationMs) * time.Millisecond,
+		Timestamp:  r.Timestamp,
+	}
+}
+
+// parseRunResult はファイル上の表記からランの結果を求めるのじゃ
+func parseRunResult(name string) (entities.RunResult, bool) {
+	for value, n := range runResultNames {
+		if n == name {
+			return value, true
+		}
+	}
+	return 0, false
+}
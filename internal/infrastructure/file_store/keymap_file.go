@@ -0,0 +1,55 @@
+package file_store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/interface/ui"
+)
+
+// keymapJSON はキーマップの設定ファイルの形式じゃ
+// 組み込みのキーマップを元に、名前で指定した操作のキーだけを置き換えるのじゃ
+type keymapJSON struct {
+	Preset   string              `json:"preset"`
+	Bindings map[string][]string `json:"bindings"`
+}
+
+// LoadKeymap はキーマップを読み込むのじゃ
+// 名前の指定が無ければ設定ファイルのpreset、それも無ければ既定のキーマップを元にするのじゃ
+// 設定ファイルが無ければ組み込みのキーマップをそのまま使うのじゃ
+func LoadKeymap(path string, preset string) (ui.Keymap, error) {
+	var raw keymapJSON
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.keymap_open"), err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T("error.keymap_broken"), err)
+		}
+	}
+
+	if preset == "" {
+		preset = raw.Preset
+	}
+	if preset == "" {
+		preset = ui.DefaultKeymap
+	}
+	keymap, ok := ui.KeymapPreset(preset)
+	if !ok {
+		return nil, errors.New(i18n.T("error.unknown_keymap", preset, ui.KeymapPresetNames()))
+	}
+
+	for name, keys := range raw.Bindings {
+		action, ok := ui.ParseAction(name)
+		if !ok {
+			return nil, errors.New(i18n.T("error.unknown_keymap_action", name))
+		}
+		keymap.Bind(action, keys)
+	}
+
+	return keymap, nil
+}
@@ -0,0 +1,142 @@
+package file_store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// LeaderboardStore はデイリーチャレンジのリーダーボードを日付ごとにJSONファイルへ保存するのじゃ
+type LeaderboardStore struct {
+	path string
+}
+
+// leaderboardJSON はファイルに書き出すリーダーボードの形式じゃ。日付をキーにするのじゃ
+type leaderboardJSON map[string][]leaderboardEntryJSON
+
+// leaderboardEntryJSON はファイルに書き出すリーダーボードの1件の形式じゃ
+type leaderboardEntryJSON struct {
+	Player    string    `json:"player"`
+	Character string    `json:"character,omitempty"`
+	Score     int       `json:"score"`
+	Floor     int       `json:"floor"`
+	Result    string    `json:"result"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewLeaderboardStore はLeaderboardStoreのインスタンスを生成するのじゃ
+func NewLeaderboardStore(dir string) *LeaderboardStore {
+	return &LeaderboardStore{path: filepath.Join(dir, "leaderboard.json")}
+}
+
+// Add はリーダーボードに記録を追加するのじゃ
+func (s *LeaderboardStore) Add(entries ...entities.LeaderboardEntry) error {
+	all, err := s.FindAll()
+	if err != nil {
+		return err
+	}
+	all = append(all, entries...)
+
+	data, err := json.MarshalIndent(toLeaderboardJSON(all), "", "  ")
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.leaderboard_encode"), err)
+	}
+	return writeFileAtomic(s.path, data)
+}
+
+// FindAll は全ての日付の記録を返すのじゃ
+func (s *LeaderboardStore) FindAll() ([]entities.LeaderboardEntry, error) {
+	file, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []entities.LeaderboardEntry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.leaderboard_open"), err)
+	}
+	defer file.Close()
+
+	return DecodeLeaderboard(file)
+}
+
+// LeaderboardCodec はリーダーボードを保存ファイルと同じJSON形式で受け渡すのじゃ
+type LeaderboardCodec struct{}
+
+// Encode はリーダーボードの記録を書き出すのじゃ
+func (LeaderboardCodec) Encode(w io.Writer, entries []entities.LeaderboardEntry) error {
+	return EncodeLeaderboard(w, entries)
+}
+
+// Decode はリーダーボードの記録を読み込むのじゃ
+func (LeaderboardCodec) Decode(r io.Reader) ([]entities.LeaderboardEntry, error) {
+	return DecodeLeaderboard(r)
+}
+
+// EncodeLeaderboard はリーダーボードの記録を書き出すのじゃ
+// 書き出した内容はそのままDecodeLeaderboardで読み込めるのじゃ
+func EncodeLeaderboard(w io.Writer, entries []entities.LeaderboardEntry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(toLeaderboardJSON(entries)); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.leaderboard_export"), err)
+	}
+	return nil
+}
+
+// DecodeLeaderboard はリーダーボードの記録を読み込むのじゃ
+func DecodeLeaderboard(r io.Reader) ([]entities.LeaderboardEntry, error) {
+	var raw leaderboardJSON
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.leaderboard_broken"), err)
+	}
+
+	// 日付の順に並べて、読み込むたびに順番が変わらないようにするのじゃ
+	dates := make([]string, 0, len(raw))
+	for date := range raw {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	entries := []entities.LeaderboardEntry{}
+	for _, date := range dates {
+		for _, entry := range raw[date] {
+			result, ok := parseRunResult(entry.Result)
+			if !ok {
+				return nil, errors.New(i18n.T("error.unknown_result", entry.Result))
+			}
+			entries = append(entries, entities.LeaderboardEntry{
+				Date:      date,
+				Player:    entry.Player,
+				Character: entry.Character,
+				Score:     entry.Score,
+				Floor:     entry.Floor,
+				Result:    result,
+				Timestamp: entry.Timestamp,
+			})
+		}
+	}
+	return entries, nil
+}
+
+// toLeaderboardJSON は記録を日付ごとにまとめるのじゃ
+func toLeaderboardJSON(entries []entities.LeaderboardEntry) leaderboardJSON {
+	raw := leaderboardJSON{}
+	for _, entry := range entries {
+		raw[entry.Date] = append(raw[entry.Date], leaderboardEntryJSON{
+			Player:    entry.Player,
+			Character: entry.Character,
+			Score:     entry.Score,
+			Floor:     entry.Floor,
+			Result:    runResultNames[entry.Result],
+			Timestamp: entry.Timestamp,
+		})
+	}
+	return raw
+}
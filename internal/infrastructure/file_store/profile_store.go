@@ -0,0 +1,59 @@
+package file_store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// ProfileStore はプロフィールをJSONファイルに保存するのじゃ
+type ProfileStore struct {
+	path string
+}
+
+// profileJSON はファイルに書き出すプロフィールの形式じゃ
+type profileJSON struct {
+	MaxAscension int `json:"max_ascension"`
+	Experience   int `json:"experience"`
+}
+
+// NewProfileStore はProfileStoreのインスタンスを生成するのじゃ
+func NewProfileStore(dir string) *ProfileStore {
+	return &ProfileStore{path: filepath.Join(dir, "profile.json")}
+}
+
+// Load はプロフィールを読み込むのじゃ
+func (s *ProfileStore) Load() (entities.Profile, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return entities.NewProfile(), nil
+	}
+	if err != nil {
+		return entities.Profile{}, fmt.Errorf("%s: %w", i18n.T("error.profile_open"), err)
+	}
+
+	var raw profileJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return entities.Profile{}, fmt.Errorf("%s: %w", i18n.T("error.profile_broken"), err)
+	}
+
+	return entities.Profile{MaxAscension: raw.MaxAscension, Experience: raw.Experience}, nil
+}
+
+// Save はプロフィールを保存するのじゃ
+func (s *ProfileStore) Save(profile entities.Profile) error {
+	data, err := json.MarshalIndent(profileJSON{
+		MaxAscension: profile.MaxAscension,
+		Experience:   profile.Experience,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.profile_encode"), err)
+	}
+
+	return writeFileAtomic(s.path, data)
+}
@@ -0,0 +1,277 @@
+package file_store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// SaveStore は中断したランをJSONファイルに保存するのじゃ
+type SaveStore struct {
+	path string
+}
+
+// savedRunJSON はファイルに書き出す中断したランの形式じゃ
+type savedRunJSON struct {
+	Seed         int64          `json:"seed"`
+	Character    string         `json:"character"`
+	Ascension    int            `json:"ascension"`
+	DailyDate    string         `json:"daily,omitempty"`
+	PlayerName   string         `json:"player_name,omitempty"`
+	Path         []int          `json:"path"`
+	InRoom       bool           `json:"in_room"`
+	Health       int            `json:"health"`
+	MaxHealth    int            `json:"max_health"`
+	Gold         int            `json:"gold"`
+	PotionSlots  int            `json:"potion_slots"`
+	CostModifier int            `json:"cost_modifier,omitempty"`
+	Deck         []string       `json:"deck"`
+	Relics       []string       `json:"relics"`
+	Potions      []string       `json:"potions"`
+	Stats        runStatsJSON   `json:"stats"`
+	ElapsedMs    int64          `json:"elapsed_ms"`
+	Rewards      []rewardJSON   `json:"rewards,omitempty"`
+	Shop         []shopItemJSON `json:"shop,omitempty"`
+	Log          []logEntryJSON `json:"log,omitempty"`
+}
+
+// rewardJSON はファイルに書き出す受け取っていない報酬の形式じゃ
+type rewardJSON struct {
+	Kind   entities.RewardKind `json:"kind"`
+	Gold   int                 `json:"gold,omitempty"`
+	Potion string              `json:"potion,omitempty"`
+	Relic  string              `json:"relic,omitempty"`
+	Cards  []string            `json:"cards,omitempty"`
+}
+
+// shopItemJSON はファイルに書き出す店の商品の形式じゃ
+type shopItemJSON struct {
+	Kind  entities.ShopItemKind `json:"kind"`
+	ID    string                `json:"id"`
+	Price int                   `json:"price"`
+	Sold  bool                  `json:"sold,omitempty"`
+}
+
+// logEntryJSON はファイルに書き出す戦闘ログの1行の形式じゃ
+type logEntryJSON struct {
+	Floor int              `json:"floor"`
+	Turn  int              `json:"turn"`
+	Kind  entities.LogKind `json:"kind"`
+	Text  messageJSON      `json:"text"`
+}
+
+// messageJSON はファイルに書き出すメッセージの形式じゃ
+// 文ではなくキーと引数で残し、読み込んだ後も今の言語で読めるようにするのじゃ
+type messageJSON struct {
+	Key    string           `json:"key"`
+	Plural bool             `json:"plural,omitempty"`
+	Args   []messageArgJSON `json:"args,omitempty"`
+}
+
+// messageArgJSON はファイルに書き出すメッセージの引数の形式じゃ
+// 数、文字列、メッセージのどれか1つだけを持つのじゃ
+type messageArgJSON struct {
+	Number  *int         `json:"number,omitempty"`
+	Text    *string      `json:"text,omitempty"`
+	Message *messageJSON `json:"message,omitempty"`
+}
+
+// runStatsJSON はファイルに書き出すランの戦績の形式じゃ
+type runStatsJSON struct {
+	FloorsClimbed int `json:"floors_climbed"`
+	EnemiesKilled int `json:"enemies_killed"`
+	ElitesKilled  int `json:"elites_killed"`
+	BossesKilled  int `json:"bosses_killed"`
+	PerfectFights int `json:"perfect_fights"`
+}
+
+// NewSaveStore はSaveStoreのインスタンスを生成するのじゃ
+func NewSaveStore(dir string) *SaveStore {
+	return &SaveStore{path: filepath.Join(dir, "save.json")}
+}
+
+// Load は中断したランを読み込むのじゃ
+func (s *SaveStore) Load() (*entities.SavedRun, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.save_open"), err)
+	}
+
+	var raw savedRunJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.save_broken"), err)
+	}
+
+	saved := &entities.SavedRun{
+		Seed:         raw.Seed,
+		Character:    raw.Character,
+		Ascension:    raw.Ascension,
+		DailyDate:    raw.DailyDate,
+		PlayerName:   raw.PlayerName,
+		Path:         raw.Path,
+		InRoom:       raw.InRoom,
+		Health:       raw.Health,
+		MaxHealth:    raw.MaxHealth,
+		Gold:         raw.Gold,
+		PotionSlots:  raw.PotionSlots,
+		CostModifier: raw.CostModifier,
+		Deck:         raw.Deck,
+		Relics:       raw.Relics,
+		Potions:      raw.Potions,
+		Stats: entities.RunStats{
+			FloorsClimbed: raw.Stats.FloorsClimbed,
+			EnemiesKilled: raw.Stats.EnemiesKilled,
+			ElitesKilled:  raw.Stats.ElitesKilled,
+			BossesKilled:  raw.Stats.BossesKilled,
+			PerfectFights: raw.Stats.PerfectFights,
+		},
+		Elapsed: time.Duration(raw.ElapsedMs) * time.Millisecond,
+	}
+	for _, reward := range raw.Rewards {
+		saved.Rewards = append(saved.Rewards, entities.SavedReward{
+			Kind:   reward.Kind,
+			Gold:   reward.Gold,
+			Potion: reward.Potion,
+			Relic:  reward.Relic,
+			Cards:  reward.Cards,
+		})
+	}
+	for _, item := range raw.Shop {
+		saved.Shop = append(saved.Shop, entities.SavedShopItem{
+			Kind:  item.Kind,
+			ID:    item.ID,
+			Price: item.Price,
+			Sold:  item.Sold,
+		})
+	}
+	for _, entry := range raw.Log {
+		saved.Log = append(saved.Log, entities.LogEntry{
+			Floor: entry.Floor,
+			Turn:  entry.Turn,
+			Kind:  entry.Kind,
+			Text:  entry.Text.message(),
+		})
+	}
+	return saved, nil
+}
+
+// Save は中断したランを保存するのじゃ
+func (s *SaveStore) Save(run entities.SavedRun) error {
+	raw := savedRunJSON{
+		Seed:         run.Seed,
+		Character:    run.Character,
+		Ascension:    run.Ascension,
+		DailyDate:    run.DailyDate,
+		PlayerName:   run.PlayerName,
+		Path:         run.Path,
+		InRoom:       run.InRoom,
+		Health:       run.Health,
+		MaxHealth:    run.MaxHealth,
+		Gold:         run.Gold,
+		PotionSlots:  run.PotionSlots,
+		CostModifier: run.CostModifier,
+		Deck:         run.Deck,
+		Relics:       run.Relics,
+		Potions:      run.Potions,
+		Stats: runStatsJSON{
+			FloorsClimbed: run.Stats.FloorsClimbed,
+			EnemiesKilled: run.Stats.EnemiesKilled,
+			ElitesKilled:  run.Stats.ElitesKilled,
+			BossesKilled:  run.Stats.BossesKilled,
+			PerfectFights: run.Stats.PerfectFights,
+		},
+		ElapsedMs: run.Elapsed.Milliseconds(),
+	}
+	for _, reward := range run.Rewards {
+		raw.Rewards = append(raw.Rewards, rewardJSON{
+			Kind:   reward.Kind,
+			Gold:   reward.Gold,
+			Potion: reward.Potion,
+			Relic:  reward.Relic,
+			Cards:  reward.Cards,
+		})
+	}
+	for _, item := range run.Shop {
+		raw.Shop = append(raw.Shop, shopItemJSON{
+			Kind:  item.Kind,
+			ID:    item.ID,
+			Price: item.Price,
+			Sold:  item.Sold,
+		})
+	}
+	for _, entry := range run.Log {
+		raw.Log = append(raw.Log, logEntryJSON{
+			Floor: entry.Floor,
+			Turn:  entry.Turn,
+			Kind:  entry.Kind,
+			Text:  newMessageJSON(entry.Text),
+		})
+	}
+
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.save_encode"), err)
+	}
+
+	return writeFileAtomic(s.path, data)
+}
+
+// Delete は中断したランを消すのじゃ
+func (s *SaveStore) Delete() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s: %w", i18n.T("error.save_delete"), err)
+	}
+	return nil
+}
+
+// newMessageJSON はメッセージをファイルに書き出す形にするのじゃ
+// 数とメッセージ以外の引数は文字列にして残すのじゃ
+func newMessageJSON(message i18n.Message) messageJSON {
+	raw := messageJSON{Key: message.Key, Plural: message.Plural}
+	for _, arg := range message.Args {
+		switch v := arg.(type) {
+		case int:
+			raw.Args = append(raw.Args, messageArgJSON{Number: &v})
+		case i18n.Message:
+			nested := newMessageJSON(v)
+			raw.Args = append(raw.Args, messageArgJSON{Message: &nested})
+		default:
+			text := fmt.Sprint(v)
+			raw.Args = append(raw.Args, messageArgJSON{Text: &text})
+		}
+	}
+	return raw
+}
+
+// message はファイルから読んだ形をメッセージに戻すのじゃ
+// 数で形を変えるのに最初の引数が数でなければ、形は変えないのじゃ
+func (m messageJSON) message() i18n.Message {
+	message := i18n.Message{Key: m.Key, Plural: m.Plural}
+	for _, arg := range m.Args {
+		switch {
+		case arg.Number != nil:
+			message.Args = append(message.Args, *arg.Number)
+		case arg.Message != nil:
+			message.Args = append(message.Args, arg.Message.message())
+		case arg.Text != nil:
+			message.Args = append(message.Args, *arg.Text)
+		}
+	}
+	if message.Plural {
+		if len(message.Args) == 0 {
+			message.Plural = false
+		} else if _, ok := message.Args[0].(int); !ok {
+			message.Plural = false
+		}
+	}
+	return message
+}
@@ -0,0 +1,96 @@
+package file_store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// SettingsStore は設定をJSONファイルに保存するのじゃ
+type SettingsStore struct {
+	path string
+}
+
+// settingsJSON はファイルに書き出す設定の形式じゃ
+type settingsJSON struct {
+	Language       string `json:"language,omitempty"`
+	Theme          string `json:"theme,omitempty"`
+	Animation      string `json:"animation"`
+	Keymap         string `json:"keymap,omitempty"`
+	ConfirmEndTurn bool   `json:"confirm_end_turn"`
+	FastEnemyTurns bool   `json:"fast_enemy_turns"`
+	DamagePreview  bool   `json:"damage_preview"`
+	LogVerbosity   string `json:"log_verbosity"`
+	FrameRate      int    `json:"frame_rate"`
+}
+
+// NewSettingsStore はSettingsStoreのインスタンスを生成するのじゃ
+func NewSettingsStore(dir string) *SettingsStore {
+	return &SettingsStore{path: filepath.Join(dir, "settings.json")}
+}
+
+// Load は設定を読み込むのじゃ
+// ファイルに書かれていない項目は既定の設定のままにするのじゃ
+func (s *SettingsStore) Load() (entities.Settings, error) {
+	defaults := entities.NewSettings()
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return defaults, nil
+	}
+	if err != nil {
+		return entities.Settings{}, fmt.Errorf("%s: %w", i18n.T("error.settings_open"), err)
+	}
+
+	raw := settingsJSON{
+		Animation:      defaults.Animation,
+		ConfirmEndTurn: defaults.ConfirmEndTurn,
+		FastEnemyTurns: defaults.FastEnemyTurns,
+		DamagePreview:  defaults.DamagePreview,
+		LogVerbosity:   defaults.LogVerbosity.String(),
+		FrameRate:      defaults.FrameRate,
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return entities.Settings{}, fmt.Errorf("%s: %w", i18n.T("error.settings_broken"), err)
+	}
+	verbosity, ok := entities.ParseLogVerbosity(raw.LogVerbosity)
+	if !ok {
+		return entities.Settings{}, errors.New(i18n.T("error.unknown_log_verbosity", raw.LogVerbosity))
+	}
+
+	return entities.Settings{
+		Language:       raw.Language,
+		Theme:          raw.Theme,
+		Animation:      raw.Animation,
+		Keymap:         raw.Keymap,
+		ConfirmEndTurn: raw.ConfirmEndTurn,
+		FastEnemyTurns: raw.FastEnemyTurns,
+		DamagePreview:  raw.DamagePreview,
+		LogVerbosity:   verbosity,
+		FrameRate:      raw.FrameRate,
+	}, nil
+}
+
+// Save は設定を保存するのじゃ
+func (s *SettingsStore) Save(settings entities.Settings) error {
+	data, err := json.MarshalIndent(settingsJSON{
+		Language:       settings.Language,
+		Theme:          settings.Theme,
+		Animation:      settings.Animation,
+		Keymap:         settings.Keymap,
+		ConfirmEndTurn: settings.ConfirmEndTurn,
+		FastEnemyTurns: settings.FastEnemyTurns,
+		DamagePreview:  settings.DamagePreview,
+		LogVerbosity:   settings.LogVerbosity.String(),
+		FrameRate:      settings.FrameRate,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.settings_encode"), err)
+	}
+
+	return writeFileAtomic(s.path, data)
+}
@@ -0,0 +1,47 @@
+package line_screen
+
+import (
+	"github.com/yanosea/cts/internal/interface/ui"
+)
+
+// EventAdapter は打ち込まれた命令をEventPortインターフェースに変換するのじゃ
+// キーやマウスの操作は無く、命令か、入力が終わったときの終了の操作だけを運ぶのじゃ
+type EventAdapter struct {
+	command ui.Command
+	action  ui.Action
+}
+
+// Action は入力が終わったときは終了の操作を、それ以外は何も割り当てられていない操作を返すのじゃ
+func (e *EventAdapter) Action() ui.Action {
+	return e.action
+}
+
+// IsAnyKey はキー入力ではないのでfalseを返すのじゃ
+func (e *EventAdapter) IsAnyKey() bool {
+	return false
+}
+
+// IsResize はリサイズではないのでfalseを返すのじゃ
+func (e *EventAdapter) IsResize() bool {
+	return false
+}
+
+// IsMouse はマウスのイベントではないのでfalseを返すのじゃ
+func (e *EventAdapter) IsMouse() bool {
+	return false
+}
+
+// IsClick はクリックではないのでfalseを返すのじゃ
+func (e *EventAdapter) IsClick() bool {
+	return false
+}
+
+// MousePosition はマウスの位置が無いので画面の外を返すのじゃ
+func (e *EventAdapter) MousePosition() (x, y int) {
+	return -1, -1
+}
+
+// Command は打ち込まれた命令を返すのじゃ。入力が終わったときは命令は無いのじゃ
+func (e *EventAdapter) Command() (ui.Command, bool) {
+	return e.command, e.action == ui.ActionNone
+}
@@ -0,0 +1,84 @@
+package line_screen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/yanosea/cts/internal/interface/ui"
+)
+
+// 命令を打ち込むときの促しじゃ
+const prompt = "> "
+
+// ScreenAdapter は標準入出力で1行ずつやりとりして遊ぶスクリーンじゃ
+// 画面には何も描かず、命令を読んでは今の様子を文章で書き出すのじゃ
+// 読み上げソフトや罫線の描けない端末、スクリプトから遊ぶのに使うのじゃ
+type ScreenAdapter struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+// NewScreenAdapter はScreenAdapterのインスタンスを生成するのじゃ
+func NewScreenAdapter(in io.Reader, out io.Writer) *ScreenAdapter {
+	return &ScreenAdapter{in: bufio.NewScanner(in), out: out}
+}
+
+// Clear は何もしないのじゃ。画面は描かないのじゃ
+func (a *ScreenAdapter) Clear() {}
+
+// Show は何もしないのじゃ。画面は描かないのじゃ
+func (a *ScreenAdapter) Show() {}
+
+// DrawText は何もしないのじゃ。様子はNarrateで受け取るのじゃ
+func (a *ScreenAdapter) DrawText(x, y int, style ui.Style, text string) {}
+
+// GetSize は画面サイズを返すのじゃ。描かないので遊べる大きさの下限を返すのじゃ
+func (a *ScreenAdapter) GetSize() (width int, height int) {
+	return 80, 24
+}
+
+// PollEvent は1行を読んで命令にするのじゃ
+// 読めない行は理由を書き出して読み直し、入力が終わったら終了の操作を返すのじゃ
+func (a *ScreenAdapter) PollEvent() ui.EventPort {
+	for {
+		fmt.Fprint(a.out, prompt)
+		if !a.in.Scan() {
+			fmt.Fprintln(a.out)
+			return &EventAdapter{action: ui.ActionQuit}
+		}
+		command, err := ui.ParseCommand(a.in.Text())
+		if err != nil {
+			a.Narrate([]string{err.Error()})
+			continue
+		}
+		return &EventAdapter{command: command}
+	}
+}
+
+// Narrate は今の様子の説明を1行ずつ書き出し、最後に空行を入れるのじゃ
+func (a *ScreenAdapter) Narrate(lines []string) {
+	for _, line := range lines {
+		fmt.Fprintln(a.out, line)
+	}
+	fmt.Fprintln(a.out)
+}
+
+// Interrupt は何もしないのじゃ。入力は同じゴルーチンで読むので、起こす相手がいないのじゃ
+func (a *ScreenAdapter) Interrupt() {}
+
+// Cleanup は何もしないのじゃ。端末の状態は変えていないのじゃ
+func (a *ScreenAdapter) Cleanup() {}
+
+// Themes は選べるテーマが無いので空の一覧を返すのじゃ
+func (a *ScreenAdapter) Themes() []string {
+	return []string{}
+}
+
+// SetTheme は何もしないのじゃ。色は使わないのじゃ
+func (a *ScreenAdapter) SetTheme(name string) error {
+	return nil
+}
+
+// SetKeyBindings は何もしないのじゃ。キーではなく命令で遊ぶのじゃ
+func (a *ScreenAdapter) SetKeyBindings(bindings ui.KeyBindings) {}
@@ -0,0 +1,110 @@
+package memory_screen
+
+import (
+	"github.com/yanosea/cts/internal/interface/ui"
+)
+
+// EventAdapter は台本に書くイベントじゃ
+// キーは押したキーではなく、キーマップで置き換えた後の操作で表すのじゃ
+type EventAdapter struct {
+	action  ui.Action
+	key     bool
+	resize  bool
+	mouse   bool
+	click   bool
+	x, y    int
+	command *ui.Command
+}
+
+// Key は操作が割り当てられたキーを押すイベントを返すのじゃ
+func Key(action ui.Action) *EventAdapter {
+	return &EventAdapter{action: action, key: true}
+}
+
+// Keys は操作が割り当てられたキーを順に押すイベントを返すのじゃ
+func Keys(actions ...ui.Action) []ui.EventPort {
+	events := make([]ui.EventPort, 0, len(actions))
+	for _, action := range actions {
+		events = append(events, Key(action))
+	}
+	return events
+}
+
+// rapidEvent は画面を見せるのを待たずに渡すイベントじゃ
+type rapidEvent struct {
+	ui.EventPort
+}
+
+// Rapid はイベントを間を空けずに続けて渡す台本を返すのじゃ
+// 最初のイベントだけ画面を見せるのを待ち、残りは描画を待たずに渡すので、描画の間に入力が重なったときを試せるのじゃ
+func Rapid(events ...ui.EventPort) []ui.EventPort {
+	script := make([]ui.EventPort, 0, len(events))
+	for i, event := range events {
+		if i > 0 {
+			event = rapidEvent{event}
+		}
+		script = append(script, event)
+	}
+	return script
+}
+
+// Click は画面の位置を左ボタンでクリックするイベントを返すのじゃ
+func Click(x, y int) *EventAdapter {
+	return &EventAdapter{mouse: true, click: true, x: x, y: y}
+}
+
+// Hover はマウスを画面の位置に重ねるイベントを返すのじゃ
+func Hover(x, y int) *EventAdapter {
+	return &EventAdapter{mouse: true, x: x, y: y}
+}
+
+// Resize は端末の大きさが変わったイベントを返すのじゃ
+func Resize() *EventAdapter {
+	return &EventAdapter{resize: true}
+}
+
+// Typed は行単位で打ち込んだ命令のイベントを返すのじゃ
+func Typed(command ui.Command) *EventAdapter {
+	return &EventAdapter{command: &command}
+}
+
+// Action はキーに割り当てられた操作を返すのじゃ
+func (e *EventAdapter) Action() ui.Action {
+	return e.action
+}
+
+// IsAnyKey はキーを押したイベントかどうかを返すのじゃ
+func (e *EventAdapter) IsAnyKey() bool {
+	return e.key
+}
+
+// IsResize はリサイズのイベントかどうかを返すのじゃ
+func (e *EventAdapter) IsResize() bool {
+	return e.resize
+}
+
+// IsMouse はマウスのイベントかどうかを返すのじゃ
+func (e *EventAdapter) IsMouse() bool {
+	return e.mouse
+}
+
+// IsClick はクリックしたイベントかどうかを返すのじゃ
+func (e *EventAdapter) IsClick() bool {
+	return e.click
+}
+
+// MousePosition はマウスの位置を返すのじゃ。マウスのイベントでなければ画面の外じゃ
+func (e *EventAdapter) MousePosition() (x, y int) {
+	if !e.mouse {
+		return -1, -1
+	}
+	return e.x, e.y
+}
+
+// Command は打ち込んだ命令を返すのじゃ。命令のイベントでなければfalseじゃ
+func (e *EventAdapter) Command() (ui.Command, bool) {
+	if e.command == nil {
+		return ui.Command{}, false
+	}
+	return *e.command, true
+}
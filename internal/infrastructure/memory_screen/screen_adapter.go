@@ -0,0 +1,230 @@
+package memory_screen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/interface/ui"
+)
+
+// cell は画面の1文字分のマスじゃ
+// 全角の文字は2マス使い、右のマスは続きとして空けておくのじゃ
+type cell struct {
+	r     rune
+	style ui.StyleType
+	cont  bool // 左の全角の文字の続きのマスかどうかじゃ
+}
+
+// styleCodes は色の種類を1文字で表すときの文字じゃ。StyleTypeの並び順に対応するのじゃ
+const styleCodes = ".Sdhbex+-curAKPC1234567"
+
+// styleCode は色の種類を表す1文字を返すのじゃ
+func styleCode(styleType ui.StyleType) byte {
+	if int(styleType) < 0 || int(styleType) >= len(styleCodes) {
+		return '?'
+	}
+	return styleCodes[styleType]
+}
+
+// ScreenAdapter はメモリ上のマスに描画するスクリーンじゃ
+// 端末を使わずにゲームを動かし、描画した画面を文字と色の種類で取り出せるのじゃ
+// イベントは台本として先に渡しておき、画面を1回見せるたびに1つずつ渡すのじゃ
+type ScreenAdapter struct {
+	width  int
+	height int
+	cells  []cell // 描画している途中の画面じゃ
+	frame  []cell // 最後に見せた画面じゃ
+	shows  int
+	// 台本のイベントのうち、まだ渡していないものじゃ
+	script []ui.EventPort
+	// 画面を見せたことと、待つのをやめることを入力を待つゴルーチンに知らせるのじゃ
+	shown     chan struct{}
+	interrupt chan struct{}
+	theme     string
+	bindings  ui.KeyBindings
+}
+
+// NewScreenAdapter はScreenAdapterのインスタンスを生成するのじゃ
+// 台本のイベントを渡し終えたら、終了の操作を渡すのじゃ
+func NewScreenAdapter(width, height int, script ...ui.EventPort) *ScreenAdapter {
+	a := &ScreenAdapter{
+		width:     width,
+		height:    height,
+		script:    script,
+		shown:     make(chan struct{}, 1),
+		interrupt: make(chan struct{}, 1),
+	}
+	a.Clear()
+	a.frame = append([]cell{}, a.cells...)
+	return a
+}
+
+// Clear は画面を空白で埋めるのじゃ
+func (a *ScreenAdapter) Clear() {
+	a.cells = make([]cell, a.width*a.height)
+	for i := range a.cells {
+		a.cells[i] = cell{r: ' '}
+	}
+}
+
+// Show は描画した画面を見せたものとして残し、次のイベントを渡せるようにするのじゃ
+func (a *ScreenAdapter) Show() {
+	a.frame = append(a.frame[:0], a.cells...)
+	a.shows++
+	select {
+	case a.shown <- struct{}{}:
+	default:
+	}
+}
+
+// DrawText はテキストを描画するのじゃ
+// 選択スタイルは端末と同じように、文字の幅の分だけ背景も塗るのじゃ
+func (a *ScreenAdapter) DrawText(x, y int, style ui.Style, text string) {
+	styleType := ui.StyleTypeOf(style)
+	if styleType == ui.SelectedStyleType {
+		for i := 0; i < runewidth.StringWidth(text); i++ {
+			a.set(x+i, y, cell{r: ' ', style: styleType})
+		}
+	}
+
+	pos := x
+	for _, r := range text {
+		w := runewidth.RuneWidth(r)
+		a.set(pos, y, cell{r: r, style: styleType})
+		if w == 2 {
+			a.set(pos+1, y, cell{style: styleType, cont: true})
+		}
+		pos += w
+	}
+}
+
+// set は画面の中なら1マスを書き換えるのじゃ
+// 全角の文字の片側だけを上書きしたら、残った側は空白にするのじゃ
+func (a *ScreenAdapter) set(x, y int, c cell) {
+	if x < 0 || x >= a.width || y < 0 || y >= a.height {
+		return
+	}
+	i := y*a.width + x
+	if a.cells[i].cont && x > 0 && !c.cont {
+		a.cells[i-1] = cell{r: ' ', style: a.cells[i-1].style}
+	}
+	if x+1 < a.width && a.cells[i+1].cont {
+		a.cells[i+1] = cell{r: ' ', style: a.cells[i+1].style}
+	}
+	a.cells[i] = c
+}
+
+// GetSize は画面サイズを返すのじゃ
+func (a *ScreenAdapter) GetSize() (width int, height int) {
+	return a.width, a.height
+}
+
+// PollEvent は画面が見せられるのを待ってから、台本の次のイベントを返すのじゃ
+// Rapidで続けて渡すイベントは待たずに返すのじゃ
+// 台本を渡し終えたら終了の操作を返し、待つのをやめるよう言われたら何も無いイベントを返すのじゃ
+func (a *ScreenAdapter) PollEvent() ui.EventPort {
+	if len(a.script) > 0 {
+		if event, ok := a.script[0].(rapidEvent); ok {
+			a.script = a.script[1:]
+			return event.EventPort
+		}
+	}
+	select {
+	case <-a.shown:
+	case <-a.interrupt:
+		return &EventAdapter{}
+	}
+	if len(a.script) == 0 {
+		return Key(ui.ActionQuit)
+	}
+	event := a.script[0]
+	a.script = a.script[1:]
+	return event
+}
+
+// Interrupt はPollEventで待っているのをやめさせるのじゃ
+func (a *ScreenAdapter) Interrupt() {
+	select {
+	case a.interrupt <- struct{}{}:
+	default:
+	}
+}
+
+// Cleanup は何もしないのじゃ。端末は使っていないのじゃ
+func (a *ScreenAdapter) Cleanup() {}
+
+// Themes は選べるテーマが無いので空の一覧を返すのじゃ
+func (a *ScreenAdapter) Themes() []string {
+	return []string{}
+}
+
+// SetTheme はテーマの名前を覚えておくだけじゃ。色の種類はそのまま残すのじゃ
+func (a *ScreenAdapter) SetTheme(name string) error {
+	a.theme = name
+	return nil
+}
+
+// SetKeyBindings はキーの割り当てを覚えておくだけじゃ。台本のイベントは操作を直接持っているのじゃ
+func (a *ScreenAdapter) SetKeyBindings(bindings ui.KeyBindings) {
+	a.bindings = bindings
+}
+
+// Shows は画面を見せた回数を返すのじゃ
+func (a *ScreenAdapter) Shows() int {
+	return a.shows
+}
+
+// Text は最後に見せた画面の文字を1行ずつ返すのじゃ。行の右端の空白は除くのじゃ
+func (a *ScreenAdapter) Text() []string {
+	lines := make([]string, a.height)
+	for y := range lines {
+		var b strings.Builder
+		for _, c := range a.frame[y*a.width : (y+1)*a.width] {
+			if !c.cont {
+				b.WriteRune(c.r)
+			}
+		}
+		lines[y] = strings.TrimRight(b.String(), " ")
+	}
+	return lines
+}
+
+// Styles は最後に見せた画面の色の種類を、1マスを1文字にして1行ずつ返すのじゃ
+// 既定のスタイルは「.」、それ以外はstyleCodesの文字で表し、行の右端の既定のスタイルは除くのじゃ
+func (a *ScreenAdapter) Styles() []string {
+	lines := make([]string, a.height)
+	for y := range lines {
+		var b strings.Builder
+		for _, c := range a.frame[y*a.width : (y+1)*a.width] {
+			b.WriteByte(styleCode(c.style))
+		}
+		lines[y] = strings.TrimRight(b.String(), ".")
+	}
+	return lines
+}
+
+// Snapshot は最後に見せた画面を、文字、色の種類、使った色の種類の凡例の順に並べた文字列で返すのじゃ
+// 画面を見比べるための記録に使うのじゃ
+func (a *ScreenAdapter) Snapshot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- text %dx%d --\n", a.width, a.height)
+	for _, line := range a.Text() {
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("-- styles --\n")
+	for _, line := range a.Styles() {
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("-- legend --\n")
+	used := map[ui.StyleType]bool{}
+	for _, c := range a.frame {
+		used[c.style] = true
+	}
+	for i := range styleCodes {
+		if styleType := ui.StyleType(i); used[styleType] && styleType != ui.DefaultStyleType {
+			fmt.Fprintf(&b, "%c %s\n", styleCodes[i], styleType)
+		}
+	}
+	return b.String()
+}
@@ -1,35 +1,42 @@
 package tcell_screen
 
 import (
+	"strings"
+
 	"github.com/gdamore/tcell/v2"
-	// "github.com/yanosea/cts/internal/interface/ui"
+	"github.com/yanosea/cts/internal/interface/ui"
 )
 
 // EventAdapter はtcellイベントをEventPortインターフェースに変換するのじゃ
 type EventAdapter struct {
-	event tcell.Event
+	event   tcell.Event
+	screen  *ScreenAdapter
+	clicked bool // 左ボタンを押した瞬間かどうかじゃ
 }
 
-// IsExit はイベントが終了要求かどうかを判定するのじゃ
-func (e *EventAdapter) IsExit() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// ESCキー、Ctrl+C、または'q'キーで終了
-		return ev.Key() == tcell.KeyEscape || ev.Key() == tcell.KeyCtrlC ||
-			(ev.Key() == tcell.KeyRune && ev.Rune() == 'q')
+// Action は押されたキーに割り当てられた操作を返すのじゃ
+// Ctrl+Cはキーマップに関係なく、いつでも終了の操作にするのじゃ
+// キーの割り当ては受け取ったときではなく、ここで読むのじゃ。入力を待つゴルーチンは割り当てに触れないのじゃ
+func (e *EventAdapter) Action() ui.Action {
+	ev, ok := e.event.(*tcell.EventKey)
+	if !ok {
+		return ui.ActionNone
+	}
+	if ev.Key() == tcell.KeyCtrlC {
+		return ui.ActionQuit
 	}
-	return false
+	return e.screen.bindings.Lookup(keyName(ev))
 }
 
-// IsEndTurn はイベントがターン終了かどうかを判定するのじゃ
-func (e *EventAdapter) IsEndTurn() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// 'e'キーまたはEnterキーでターン終了
-		return (ev.Key() == tcell.KeyRune && ev.Rune() == 'e') || ev.Key() == tcell.KeyEnter || 
-			(ev.Key() == tcell.KeyRune && ev.Rune() == ';') // セミコロンを追加
+// keyName はキーマップで使うキーの名前を返すのじゃ
+func keyName(ev *tcell.EventKey) string {
+	if ev.Key() == tcell.KeyRune {
+		return ui.NormalizeKeyName(string(ev.Rune()))
 	}
-	return false
+	if name, ok := tcell.KeyNames[ev.Key()]; ok {
+		return strings.ToLower(name)
+	}
+	return ""
 }
 
 // IsAnyKey はイベントが何かしらのキー入力かどうかを判定するのじゃ
@@ -44,118 +51,26 @@ func (e *EventAdapter) IsResize() bool {
 	return ok
 }
 
-// GetCardIndex はイベントからカードインデックスを取得するのじゃ
-// 既存の数字キー入力もサポートしておくのじゃ
-func (e *EventAdapter) GetCardIndex() int {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		if ev.Key() == tcell.KeyRune {
-			switch ev.Rune() {
-			case '1':
-				return 0
-			case '2':
-				return 1
-			case '3':
-				return 2
-			case '4':
-				return 3
-			case '5':
-				return 4
-			}
-		}
-	}
-	return -1
-}
-
-// IsSKey はSキーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsSKey() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		return ev.Key() == tcell.KeyRune && (ev.Rune() == 's' || ev.Rune() == 'S')
-	}
-	return false
-}
-
-// IsKey1 は1キーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsKey1() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		return ev.Key() == tcell.KeyRune && ev.Rune() == '1'
-	}
-	return false
-}
-
-// IsKey2 は2キーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsKey2() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		return ev.Key() == tcell.KeyRune && ev.Rune() == '2'
-	}
-	return false
-}
-
-// Vim風の操作に必要なイベント判定を追加するのじゃ
-
-// IsUp は上方向キーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsUp() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// 上矢印キー、'k'キー、または'i'キーで上
-		return ev.Key() == tcell.KeyUp || 
-			(ev.Key() == tcell.KeyRune && (ev.Rune() == 'k' || ev.Rune() == 'K' || ev.Rune() == 'i' || ev.Rune() == 'I'))
-	}
-	return false
-}
-
-// IsDown は下方向キーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsDown() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// 下矢印キー、'j'キー、または','(コンマ)キーで下
-		return ev.Key() == tcell.KeyDown || 
-			(ev.Key() == tcell.KeyRune && (ev.Rune() == 'j' || ev.Rune() == 'J' || ev.Rune() == ',' || ev.Rune() == '.'))
-	}
-	return false
-}
-
-// IsLeft は左方向キーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsLeft() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// 左矢印キー、'h'キー、または'j'キーで左
-		return ev.Key() == tcell.KeyLeft || 
-			(ev.Key() == tcell.KeyRune && (ev.Rune() == 'h' || ev.Rune() == 'H' || ev.Rune() == 'j' || ev.Rune() == 'J'))
-	}
-	return false
+// IsMouse はマウスのイベントかどうかを判定するのじゃ
+func (e *EventAdapter) IsMouse() bool {
+	_, ok := e.event.(*tcell.EventMouse)
+	return ok
 }
 
-// IsRight は右方向キーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsRight() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// 右矢印キー、'l'キー、または'l'キーで右
-		return ev.Key() == tcell.KeyRight || 
-			(ev.Key() == tcell.KeyRune && (ev.Rune() == 'l' || ev.Rune() == 'L'))
-	}
-	return false
+// IsClick は左ボタンが押されたかを判定するのじゃ
+func (e *EventAdapter) IsClick() bool {
+	return e.clicked
 }
 
-// IsEnter はEnterキーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsEnter() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// Enterキーまたは';'(セミコロン)キーで決定
-		return ev.Key() == tcell.KeyEnter || (ev.Key() == tcell.KeyRune && ev.Rune() == ';')
+// MousePosition はマウスの位置を返すのじゃ
+func (e *EventAdapter) MousePosition() (x, y int) {
+	if ev, ok := e.event.(*tcell.EventMouse); ok {
+		return ev.Position()
 	}
-	return false
+	return -1, -1
 }
 
-// IsSpace はスペースキーが押されたかを判定するのじゃ
-func (e *EventAdapter) IsSpace() bool {
-	switch ev := e.event.(type) {
-	case *tcell.EventKey:
-		// スペースキーまたは'/'(スラッシュ)キーで決定
-		return (ev.Key() == tcell.KeyRune && (ev.Rune() == ' ' || ev.Rune() == '/'))
-	}
-	return false
+// Command は命令を返すのじゃ。キーで操作するので命令は無いのじゃ
+func (e *EventAdapter) Command() (ui.Command, bool) {
+	return ui.Command{}, false
 }
@@ -2,16 +2,22 @@ package tcell_screen
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/i18n"
 	"github.com/yanosea/cts/internal/interface/ui"
 )
 
 // ScreenAdapter はtcellライブラリを使用して画面表示を実装するのじゃ
 type ScreenAdapter struct {
 	screen tcell.Screen
+	theme  Theme
+	// テーマを切り替えるときに読み直すテーマファイルの場所じゃ
+	themeFile string
+	bindings  ui.KeyBindings
+	// 直前のマウスのボタンの状態じゃ。押した瞬間だけをクリックとみなすのに使うのじゃ
+	buttons tcell.ButtonMask
 }
 
 // styleContainer はスタイル情報を持つ構造体じゃ
@@ -20,21 +26,23 @@ type styleContainer struct {
 }
 
 // NewScreenAdapter はScreenAdapterのインスタンスを生成するのじゃ
-func NewScreenAdapter() (*ScreenAdapter, error) {
+// スタイルの色はテーマから、キーの操作はキーの割り当てから決めるのじゃ
+func NewScreenAdapter(theme Theme, themeFile string, bindings ui.KeyBindings) (*ScreenAdapter, error) {
 	screen, err := tcell.NewScreen()
 	if err != nil {
-		return nil, fmt.Errorf("スクリーンの初期化に失敗じゃ: %v", err)
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.screen_init"), err)
 	}
 
 	if err := screen.Init(); err != nil {
-		return nil, fmt.Errorf("スクリーンの初期化に失敗じゃ: %v", err)
+		return nil, fmt.Errorf("%s: %w", i18n.T("error.screen_init"), err)
 	}
 
 	// 基本的なスタイルを設定するのじゃ
-	screen.SetStyle(tcell.StyleDefault.Background(tcell.ColorBlack).Foreground(tcell.ColorWhite))
+	screen.SetStyle(theme.Style(ui.DefaultStyleType))
+	screen.EnableMouse()
 	screen.Clear()
 
-	return &ScreenAdapter{screen: screen}, nil
+	return &ScreenAdapter{screen: screen, theme: theme, themeFile: themeFile, bindings: bindings}, nil
 }
 
 // Clear は画面をクリアするのじゃ
@@ -49,24 +57,15 @@ func (a *ScreenAdapter) Show() {
 
 // DrawText はテキストを描画するのじゃ
 func (a *ScreenAdapter) DrawText(x, y int, style ui.Style, text string) {
-	// スタイル設定
-	var tcellStyle tcell.Style
-
-	if style == nil {
-		tcellStyle = tcell.StyleDefault
-	} else if styleContainer, ok := style.(*ui.StyleTypeContainer); ok && styleContainer.Type == ui.SelectedStyleType {
-		// 選択中のスタイルはグレー背景、黒文字にする
-		tcellStyle = tcell.StyleDefault.Background(tcell.ColorLightGray).Foreground(tcell.ColorBlack)
-	} else {
-		// その他のスタイルはデフォルトのまま
-		tcellStyle = tcell.StyleDefault
-	}
+	// スタイルの種類に対応する色をテーマから取り出すのじゃ
+	styleType := ui.StyleTypeOf(style)
+	tcellStyle := a.theme.Style(styleType)
 
 	// runewidthを使って正確なテキスト幅を計算するのじゃ
 	width := runewidth.StringWidth(text)
 
 	// 選択スタイルの場合は、必ず先に背景を描画するのじゃ
-	if styleContainer, ok := style.(*ui.StyleTypeContainer); ok && styleContainer.Type == ui.SelectedStyleType {
+	if styleType == ui.SelectedStyleType {
 		// テキストの全幅に対して背景色を描画するのじゃ
 		for i := 0; i < width; i++ {
 			// スペースを背景色付きで描画することで、背景を埋めるのじゃ
@@ -96,16 +95,47 @@ func (a *ScreenAdapter) GetSize() (width int, height int) {
 }
 
 // PollEvent はイベントを取得するのじゃ
+// マウスのボタンは押し続けている間も届くので、押した瞬間だけをクリックにするのじゃ
+// 描画とは別のゴルーチンから呼ばれるので、ボタンの状態のほかには触れないのじゃ
 func (a *ScreenAdapter) PollEvent() ui.EventPort {
-	return &EventAdapter{event: a.screen.PollEvent()}
+	event := a.screen.PollEvent()
+	clicked := false
+	if ev, ok := event.(*tcell.EventMouse); ok {
+		buttons := ev.Buttons()
+		clicked = buttons&tcell.Button1 != 0 && a.buttons&tcell.Button1 == 0
+		a.buttons = buttons
+	}
+	return &EventAdapter{event: event, screen: a, clicked: clicked}
 }
 
-// Sleep は指定されたミリ秒だけスリープするのじゃ
-func (a *ScreenAdapter) Sleep(ms int) {
-	time.Sleep(time.Duration(ms) * time.Millisecond)
+// Interrupt はPollEventで待っているところに割り込みのイベントを送って起こすのじゃ
+func (a *ScreenAdapter) Interrupt() {
+	_ = a.screen.PostEvent(tcell.NewEventInterrupt(nil))
 }
 
 // Cleanup は画面を終了するのじゃ
 func (a *ScreenAdapter) Cleanup() {
 	a.screen.Fini()
 }
+
+// Themes は組み込みのテーマの名前を返すのじゃ
+func (a *ScreenAdapter) Themes() []string {
+	return ThemeNames()
+}
+
+// SetTheme はテーマを読み込み直して切り替えるのじゃ
+// テーマファイルの上書きは切り替えた後のテーマにも当てはめるのじゃ
+func (a *ScreenAdapter) SetTheme(name string) error {
+	theme, err := LoadTheme(name, a.themeFile)
+	if err != nil {
+		return err
+	}
+	a.theme = theme
+	a.screen.SetStyle(theme.Style(ui.DefaultStyleType))
+	return nil
+}
+
+// SetKeyBindings はキーの割り当てを切り替えるのじゃ
+func (a *ScreenAdapter) SetKeyBindings(bindings ui.KeyBindings) {
+	a.bindings = bindings
+}
@@ -0,0 +1,266 @@
+package tcell_screen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/interface/ui"
+)
+
+// Theme はスタイルの種類ごとの実際の色の組み合わせじゃ
+type Theme struct {
+	Name   string
+	styles map[ui.StyleType]tcell.Style
+}
+
+// Style はスタイルの種類に対応する色を返すのじゃ
+// テーマに無い種類はデフォルトの色にするのじゃ
+func (t Theme) Style(styleType ui.StyleType) tcell.Style {
+	if style, ok := t.styles[styleType]; ok {
+		return style
+	}
+	return t.styles[ui.DefaultStyleType]
+}
+
+// 組み込みのテーマの名前じゃ
+const (
+	ThemeDark       = "dark"
+	ThemeLight      = "light"
+	ThemeColorblind = "colorblind"
+	ThemeMono       = "mono"
+)
+
+// builtinThemes は組み込みのテーマを作る関数の一覧じゃ
+var builtinThemes = map[string]func() map[ui.StyleType]tcell.Style{
+	ThemeDark:       darkTheme,
+	ThemeLight:      lightTheme,
+	ThemeColorblind: colorblindTheme,
+	ThemeMono:       monoTheme,
+}
+
+// ThemeNames は組み込みのテーマの名前を返すのじゃ
+func ThemeNames() []string {
+	names := make([]string, 0, len(builtinThemes))
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// darkTheme は黒い背景向けのテーマじゃ
+func darkTheme() map[ui.StyleType]tcell.Style {
+	base := tcell.StyleDefault.Background(tcell.ColorBlack).Foreground(tcell.ColorWhite)
+	return map[ui.StyleType]tcell.Style{
+		ui.DefaultStyleType:      base,
+		ui.SelectedStyleType:     tcell.StyleDefault.Background(tcell.ColorLightGray).Foreground(tcell.ColorBlack),
+		ui.DisabledStyleType:     base.Foreground(tcell.ColorGray),
+		ui.HPStyleType:           base.Foreground(tcell.ColorRed),
+		ui.BlockStyleType:        base.Foreground(tcell.ColorDeepSkyBlue),
+		ui.EnergyStyleType:       base.Foreground(tcell.ColorGold),
+		ui.DamageStyleType:       base.Foreground(tcell.ColorOrangeRed).Bold(true),
+		ui.BuffStyleType:         base.Foreground(tcell.ColorLime),
+		ui.DebuffStyleType:       base.Foreground(tcell.ColorViolet),
+		ui.CommonStyleType:       base,
+		ui.UncommonStyleType:     base.Foreground(tcell.ColorDeepSkyBlue),
+		ui.RareStyleType:         base.Foreground(tcell.ColorGold).Bold(true),
+		ui.AttackStyleType:       base.Foreground(tcell.ColorIndianRed),
+		ui.SkillStyleType:        base.Foreground(tcell.ColorLightSkyBlue),
+		ui.PowerStyleType:        base.Foreground(tcell.ColorGold),
+		ui.CurseStyleType:        base.Foreground(tcell.ColorPurple),
+		ui.EnemyNodeStyleType:    base.Foreground(tcell.ColorIndianRed),
+		ui.EliteNodeStyleType:    base.Foreground(tcell.ColorOrangeRed).Bold(true),
+		ui.BossNodeStyleType:     base.Foreground(tcell.ColorRed).Bold(true),
+		ui.RestNodeStyleType:     base.Foreground(tcell.ColorLime),
+		ui.ShopNodeStyleType:     base.Foreground(tcell.ColorGold),
+		ui.TreasureNodeStyleType: base.Foreground(tcell.ColorYellow),
+		ui.EventNodeStyleType:    base.Foreground(tcell.ColorLightSkyBlue),
+	}
+}
+
+// lightTheme は白い背景向けのテーマじゃ
+func lightTheme() map[ui.StyleType]tcell.Style {
+	base := tcell.StyleDefault.Background(tcell.ColorWhite).Foreground(tcell.ColorBlack)
+	return map[ui.StyleType]tcell.Style{
+		ui.DefaultStyleType:      base,
+		ui.SelectedStyleType:     tcell.StyleDefault.Background(tcell.ColorDarkSlateGray).Foreground(tcell.ColorWhite),
+		ui.DisabledStyleType:     base.Foreground(tcell.ColorDarkGray),
+		ui.HPStyleType:           base.Foreground(tcell.ColorDarkRed),
+		ui.BlockStyleType:        base.Foreground(tcell.ColorNavy),
+		ui.EnergyStyleType:       base.Foreground(tcell.ColorDarkOrange),
+		ui.DamageStyleType:       base.Foreground(tcell.ColorRed).Bold(true),
+		ui.BuffStyleType:         base.Foreground(tcell.ColorGreen),
+		ui.DebuffStyleType:       base.Foreground(tcell.ColorPurple),
+		ui.CommonStyleType:       base,
+		ui.UncommonStyleType:     base.Foreground(tcell.ColorNavy),
+		ui.RareStyleType:         base.Foreground(tcell.ColorDarkGoldenrod).Bold(true),
+		ui.AttackStyleType:       base.Foreground(tcell.ColorDarkRed),
+		ui.SkillStyleType:        base.Foreground(tcell.ColorNavy),
+		ui.PowerStyleType:        base.Foreground(tcell.ColorDarkGoldenrod),
+		ui.CurseStyleType:        base.Foreground(tcell.ColorPurple),
+		ui.EnemyNodeStyleType:    base.Foreground(tcell.ColorDarkRed),
+		ui.EliteNodeStyleType:    base.Foreground(tcell.ColorRed).Bold(true),
+		ui.BossNodeStyleType:     base.Foreground(tcell.ColorMaroon).Bold(true),
+		ui.RestNodeStyleType:     base.Foreground(tcell.ColorGreen),
+		ui.ShopNodeStyleType:     base.Foreground(tcell.ColorDarkOrange),
+		ui.TreasureNodeStyleType: base.Foreground(tcell.ColorDarkGoldenrod),
+		ui.EventNodeStyleType:    base.Foreground(tcell.ColorNavy),
+	}
+}
+
+// colorblindTheme は色覚の違いがあっても見分けやすい配色のテーマじゃ
+// 赤と緑の組み合わせを避け、明るさと太字でも区別できるようにするのじゃ
+func colorblindTheme() map[ui.StyleType]tcell.Style {
+	base := tcell.StyleDefault.Background(tcell.ColorBlack).Foreground(tcell.ColorWhite)
+	orange := tcell.NewHexColor(0xE69F00)
+	skyBlue := tcell.NewHexColor(0x56B4E9)
+	bluishGreen := tcell.NewHexColor(0x009E73)
+	yellow := tcell.NewHexColor(0xF0E442)
+	blue := tcell.NewHexColor(0x0072B2)
+	vermillion := tcell.NewHexColor(0xD55E00)
+	purple := tcell.NewHexColor(0xCC79A7)
+	return map[ui.StyleType]tcell.Style{
+		ui.DefaultStyleType:      base,
+		ui.SelectedStyleType:     tcell.StyleDefault.Background(yellow).Foreground(tcell.ColorBlack),
+		ui.DisabledStyleType:     base.Foreground(tcell.ColorGray),
+		ui.HPStyleType:           base.Foreground(vermillion).Bold(true),
+		ui.BlockStyleType:        base.Foreground(skyBlue),
+		ui.EnergyStyleType:       base.Foreground(yellow),
+		ui.DamageStyleType:       base.Foreground(vermillion).Bold(true),
+		ui.BuffStyleType:         base.Foreground(bluishGreen),
+		ui.DebuffStyleType:       base.Foreground(purple),
+		ui.CommonStyleType:       base,
+		ui.UncommonStyleType:     base.Foreground(skyBlue),
+		ui.RareStyleType:         base.Foreground(orange).Bold(true),
+		ui.AttackStyleType:       base.Foreground(vermillion),
+		ui.SkillStyleType:        base.Foreground(skyBlue),
+		ui.PowerStyleType:        base.Foreground(orange),
+		ui.CurseStyleType:        base.Foreground(purple),
+		ui.EnemyNodeStyleType:    base.Foreground(vermillion),
+		ui.EliteNodeStyleType:    base.Foreground(orange).Bold(true),
+		ui.BossNodeStyleType:     base.Foreground(vermillion).Bold(true).Underline(true),
+		ui.RestNodeStyleType:     base.Foreground(bluishGreen),
+		ui.ShopNodeStyleType:     base.Foreground(yellow),
+		ui.TreasureNodeStyleType: base.Foreground(orange),
+		ui.EventNodeStyleType:    base.Foreground(blue),
+	}
+}
+
+// monoTheme は色を使わないテーマじゃ
+// 選択は反転、使えないものは暗く、目立たせたいものは太字で表すのじゃ
+func monoTheme() map[ui.StyleType]tcell.Style {
+	base := tcell.StyleDefault
+	return map[ui.StyleType]tcell.Style{
+		ui.DefaultStyleType:   base,
+		ui.SelectedStyleType:  base.Reverse(true),
+		ui.DisabledStyleType:  base.Dim(true),
+		ui.DamageStyleType:    base.Bold(true),
+		ui.RareStyleType:      base.Bold(true),
+		ui.CurseStyleType:     base.Underline(true),
+		ui.EliteNodeStyleType: base.Bold(true),
+		ui.BossNodeStyleType:  base.Bold(true).Underline(true),
+	}
+}
+
+// themeFileJSON はテーマファイルの形式じゃ
+// 組み込みのテーマを元に、名前で指定したスタイルだけを上書きするのじゃ
+type themeFileJSON struct {
+	Base   string                   `json:"base"`
+	Styles map[string]styleSpecJSON `json:"styles"`
+}
+
+// styleSpecJSON はテーマファイルに書く1つのスタイルの形式じゃ
+// 色はtcellの色の名前か#rrggbbで書くのじゃ
+type styleSpecJSON struct {
+	Foreground string `json:"fg,omitempty"`
+	Background string `json:"bg,omitempty"`
+	Bold       bool   `json:"bold,omitempty"`
+	Dim        bool   `json:"dim,omitempty"`
+	Underline  bool   `json:"underline,omitempty"`
+	Reverse    bool   `json:"reverse,omitempty"`
+}
+
+// LoadTheme は使うテーマを決めるのじゃ
+// 名前の指定が無ければテーマファイルのbase、それも無ければNO_COLORに従って選ぶのじゃ
+// NO_COLORは空でない値が入っているときだけ色を消すのじゃ
+// テーマファイルがあればそのスタイルで上書きするのじゃ
+func LoadTheme(name string, path string) (Theme, error) {
+	var file themeFileJSON
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return Theme{}, fmt.Errorf("%s: %w", i18n.T("error.theme_open"), err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &file); err != nil {
+			return Theme{}, fmt.Errorf("%s: %w", i18n.T("error.theme_broken"), err)
+		}
+	}
+
+	if name == "" {
+		name = file.Base
+	}
+	if name == "" {
+		name = ThemeDark
+		if os.Getenv("NO_COLOR") != "" {
+			name = ThemeMono
+		}
+	}
+
+	create, ok := builtinThemes[name]
+	if !ok {
+		return Theme{}, errors.New(i18n.T("error.unknown_theme", name, ThemeNames()))
+	}
+	theme := Theme{Name: name, styles: create()}
+
+	for styleName, spec := range file.Styles {
+		styleType, ok := ui.ParseStyleType(styleName)
+		if !ok {
+			return Theme{}, errors.New(i18n.T("error.unknown_theme_style", styleName))
+		}
+		style, err := spec.apply(theme.Style(ui.DefaultStyleType))
+		if err != nil {
+			return Theme{}, err
+		}
+		theme.styles[styleType] = style
+	}
+
+	return theme, nil
+}
+
+// apply はテーマファイルのスタイルを元のスタイルに重ねるのじゃ
+// 知らない色の名前があればエラーを返すのじゃ
+func (s styleSpecJSON) apply(style tcell.Style) (tcell.Style, error) {
+	if s.Foreground != "" {
+		color, err := parseColor(s.Foreground)
+		if err != nil {
+			return style, err
+		}
+		style = style.Foreground(color)
+	}
+	if s.Background != "" {
+		color, err := parseColor(s.Background)
+		if err != nil {
+			return style, err
+		}
+		style = style.Background(color)
+	}
+	return style.Bold(s.Bold).Dim(s.Dim).Underline(s.Underline).Reverse(s.Reverse), nil
+}
+
+// parseColor は色の名前か#rrggbbの形の値を色にするのじゃ
+// tcellは知らない名前を既定の色にしてしまうので、既定の色はdefaultと書いたときだけにするのじゃ
+func parseColor(name string) (tcell.Color, error) {
+	if name == "default" {
+		return tcell.ColorDefault, nil
+	}
+	color := tcell.GetColor(name)
+	if color == tcell.ColorDefault {
+		return color, errors.New(i18n.T("error.unknown_theme_color", name))
+	}
+	return color, nil
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// DailyCommand は `cts daily` サブコマンドを実装するのじゃ
+type DailyCommand struct {
+	dailyInteractor *usecase.DailyInteractor
+	out             io.Writer
+	now             func() time.Time
+}
+
+// NewDailyCommand はDailyCommandのインスタンスを生成するのじゃ
+func NewDailyCommand(dailyInteractor *usecase.DailyInteractor, out io.Writer) *DailyCommand {
+	return &DailyCommand{
+		dailyInteractor: dailyInteractor,
+		out:             out,
+		now:             time.Now,
+	}
+}
+
+// Run はサブコマンドを実行するのじゃ
+//
+//	cts daily [--date YYYY-MM-DD]
+//	cts daily export [--date YYYY-MM-DD] [--output ファイル]
+//	cts daily import <ファイル>
+func (c *DailyCommand) Run(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "export":
+			return c.export(args[1:])
+		case "import":
+			return c.importFile(args[1:])
+		}
+	}
+
+	flags := flag.NewFlagSet("daily", flag.ContinueOnError)
+	flags.SetOutput(c.out)
+	date := flags.String("date", "", i18n.T("flag.daily_date"))
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	daily, err := c.challengeFor(*date)
+	if err != nil {
+		return err
+	}
+	return c.show(daily)
+}
+
+// challengeFor は指定された日付のデイリーチャレンジを返すのじゃ
+func (c *DailyCommand) challengeFor(date string) (entities.DailyChallenge, error) {
+	if date == "" {
+		return entities.NewDailyChallenge(c.now()), nil
+	}
+
+	day, err := time.Parse(entities.DailyDateFormat, date)
+	if err != nil {
+		return entities.DailyChallenge{}, errors.New(i18n.T("error.date_format", date))
+	}
+	return entities.NewDailyChallenge(day), nil
+}
+
+// show はデイリーチャレンジの内容とリーダーボードを表示するのじゃ
+func (c *DailyCommand) show(daily entities.DailyChallenge) error {
+	fmt.Fprintln(c.out, i18n.T("daily.title", daily.Date, daily.Seed))
+	for _, modifier := range daily.Modifiers {
+		fmt.Fprintf(c.out, "  - %s\n", entities.GetDailyModifierDescription(modifier))
+	}
+	fmt.Fprintln(c.out)
+
+	entries, err := c.dailyInteractor.GetLeaderboard(daily.Date)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Fprintln(c.out, i18n.T("daily.empty"))
+		return nil
+	}
+
+	for rank, entry := range entries {
+		fmt.Fprintln(c.out, i18n.T("daily.row",
+			rank+1,
+			runewidth.FillRight(entry.Player, 16),
+			runewidth.FillRight(entities.GetCharacterName(entry.Character), 16),
+			entry.Score,
+			runewidth.FillRight(entities.GetRunResultName(entry.Result).String(), 4),
+			entry.Floor,
+		))
+	}
+	return nil
+}
+
+// export はリーダーボードを他の人に渡せる形で書き出すのじゃ
+func (c *DailyCommand) export(args []string) error {
+	flags := flag.NewFlagSet("daily export", flag.ContinueOnError)
+	flags.SetOutput(c.out)
+	date := flags.String("date", "", i18n.T("flag.daily_export_date"))
+	output := flags.String("output", "", i18n.T("flag.daily_export_output"))
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err := c.dailyInteractor.Export(c.out, *date)
+		return err
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.export_open"), err)
+	}
+	defer file.Close()
+
+	count, err := c.dailyInteractor.Export(file, *date)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.out, i18n.T("daily.exported", i18n.Plural("count.records", count), *output))
+	return nil
+}
+
+// importFile は他の人が書き出したリーダーボードを取り込むのじゃ
+func (c *DailyCommand) importFile(args []string) error {
+	if len(args) != 1 {
+		return errors.New(i18n.T("usage.daily_import"))
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("error.import_open"), err)
+	}
+	defer file.Close()
+
+	count, err := c.dailyInteractor.Import(file)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.out, i18n.T("daily.imported", i18n.Plural("count.records", count)))
+	return nil
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// HistoryCommand は `cts history` サブコマンドを実装するのじゃ
+type HistoryCommand struct {
+	historyInteractor *usecase.HistoryInteractor
+	out               io.Writer
+}
+
+// NewHistoryCommand はHistoryCommandのインスタンスを生成するのじゃ
+func NewHistoryCommand(historyInteractor *usecase.HistoryInteractor, out io.Writer) *HistoryCommand {
+	return &HistoryCommand{
+		historyInteractor: historyInteractor,
+		out:               out,
+	}
+}
+
+// コマンドラインで指定するランの結果の名前じゃ
+var resultFlagValues = map[string]entities.RunResult{
+	"victory":   entities.ResultVictory,
+	"defeat":    entities.ResultDefeat,
+	"abandoned": entities.ResultAbandoned,
+}
+
+// Run はサブコマンドを実行するのじゃ
+//
+//	cts history [--character ID] [--result victory|defeat|abandoned] [--min-floor N] [--seed N] [--limit N] [--by-score]
+//	cts history show <ID>
+func (c *HistoryCommand) Run(args []string) error {
+	if len(args) > 0 && args[0] == "show" {
+		if len(args) != 2 {
+			return errors.New(i18n.T("usage.history_show"))
+		}
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return errors.New(i18n.T("error.id_not_number", args[1]))
+		}
+		return c.show(id)
+	}
+
+	flags := flag.NewFlagSet("history", flag.ContinueOnError)
+	flags.SetOutput(c.out)
+	character := flags.String("character", "", i18n.T("flag.history_character"))
+	result := flags.String("result", "", i18n.T("flag.history_result"))
+	minFloor := flags.Int("min-floor", 0, i18n.T("flag.history_min_floor"))
+	seed := flags.String("seed", "", i18n.T("flag.history_seed"))
+	limit := flags.Int("limit", 20, i18n.T("flag.history_limit"))
+	byScore := flags.Bool("by-score", false, i18n.T("flag.history_by_score"))
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *character != "" {
+		if _, ok := entities.GetCharacterByID(*character); !ok {
+			return errors.New(i18n.T("error.unknown_character", *character))
+		}
+	}
+
+	filter := usecase.HistoryFilter{
+		Character: *character,
+		MinFloor:  *minFloor,
+		Limit:     *limit,
+		ByScore:   *byScore,
+	}
+	if *result != "" {
+		value, ok := resultFlagValues[*result]
+		if !ok {
+			return errors.New(i18n.T("error.unknown_result", *result))
+		}
+		filter.Result = &value
+	}
+	if *seed != "" {
+		value, err := strconv.ParseInt(*seed, 10, 64)
+		if err != nil {
+			return errors.New(i18n.T("error.seed_not_number", *seed))
+		}
+		filter.Seed = &value
+	}
+
+	return c.list(filter)
+}
+
+// list はランの一覧を表示するのじゃ
+func (c *HistoryCommand) list(filter usecase.HistoryFilter) error {
+	records, err := c.historyInteractor.ListRuns(filter)
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		fmt.Fprintln(c.out, i18n.T("history.empty"))
+		return nil
+	}
+
+	for _, record := range records {
+		fmt.Fprintln(c.out, formatRunSummary(record))
+	}
+	return nil
+}
+
+// show はランの詳細を表示するのじゃ
+func (c *HistoryCommand) show(id int) error {
+	record, err := c.historyInteractor.GetRun(id)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range formatRunDetail(record) {
+		fmt.Fprintln(c.out, line)
+	}
+	return nil
+}
+
+// formatRunSummary はランの記録を1行にまとめるのじゃ
+func formatRunSummary(record entities.RunRecord) string {
+	return i18n.T("history.summary",
+		record.ID,
+		record.Timestamp.Local().Format("2006-01-02 15:04"),
+		record.GetResultName(),
+		record.Floor,
+		entities.GetCharacterName(record.Character),
+		record.Ascension,
+		record.Gold,
+		record.Score,
+	)
+}
+
+// formatRunDetail はランの記録の詳細を行ごとに返すのじゃ
+func formatRunDetail(record entities.RunRecord) []string {
+	lines := []string{
+		i18n.T("history.run_id", record.ID),
+		i18n.T("history.date", record.Timestamp.Local().Format("2006-01-02 15:04:05")),
+		i18n.T("history.seed", record.Seed),
+		i18n.T("history.character_only", entities.GetCharacterName(record.Character)),
+		i18n.T("history.ascension", record.Ascension),
+		i18n.T("history.result_only", record.GetResultName()),
+		i18n.T("history.floor", record.Floor),
+	}
+	if record.KilledBy != "" {
+		lines = append(lines, i18n.T("history.killed_by", record.KilledBy))
+	}
+	lines = append(lines,
+		i18n.T("history.gold_only", i18n.Number(record.Gold)),
+		i18n.T("history.duration", record.Duration.Round(time.Second)),
+		i18n.T("history.score", i18n.Number(record.Score)),
+	)
+	for _, item := range record.ScoreItems {
+		lines = append(lines, fmt.Sprintf("  %s %s %+d", runewidth.FillRight(item.Name, 16), runewidth.FillRight(item.Detail, 14), item.Points))
+	}
+	lines = append(lines, i18n.T("history.deck", i18n.Plural("count.cards", len(record.Deck)), strings.Join(record.Deck, ", ")))
+	if len(record.Relics) > 0 {
+		lines = append(lines, i18n.T("history.relics", strings.Join(record.Relics, ", ")))
+	} else {
+		lines = append(lines, i18n.T("history.relics", i18n.T("history.none")))
+	}
+	return lines
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// ProfileCommand は `cts profile` サブコマンドを実装するのじゃ
+type ProfileCommand struct {
+	profileInteractor *usecase.ProfileInteractor
+	out               io.Writer
+}
+
+// NewProfileCommand はProfileCommandのインスタンスを生成するのじゃ
+func NewProfileCommand(profileInteractor *usecase.ProfileInteractor, out io.Writer) *ProfileCommand {
+	return &ProfileCommand{
+		profileInteractor: profileInteractor,
+		out:               out,
+	}
+}
+
+// Run はサブコマンドを実行するのじゃ
+//
+//	cts profile
+//	cts profile reset --yes
+func (c *ProfileCommand) Run(args []string) error {
+	if len(args) > 0 && args[0] == "reset" {
+		return c.reset(args[1:])
+	}
+
+	profile, err := c.profileInteractor.GetProfile()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, i18n.T("profile.experience", profile.Experience))
+	fmt.Fprintln(c.out, i18n.T("profile.tiers", profile.UnlockedTierCount()))
+	if next := profile.NextTier(); next != nil {
+		fmt.Fprintln(c.out, i18n.T("profile.next_tier", next.RequiredExperience-profile.Experience))
+	} else {
+		fmt.Fprintln(c.out, i18n.T("profile.all_unlocked"))
+	}
+	fmt.Fprintln(c.out, i18n.T("profile.max_ascension", profile.MaxAscension))
+	return nil
+}
+
+// reset はプロフィールを初期状態に戻すのじゃ
+func (c *ProfileCommand) reset(args []string) error {
+	flags := flag.NewFlagSet("profile reset", flag.ContinueOnError)
+	flags.SetOutput(c.out)
+	yes := flags.Bool("yes", false, i18n.T("flag.profile_reset_yes"))
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if !*yes {
+		return errors.New(i18n.T("error.profile_reset_confirm"))
+	}
+
+	if err := c.profileInteractor.ResetProfile(); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.out, i18n.T("profile.reset"))
+	return nil
+}
@@ -0,0 +1,102 @@
+package ui
+
+// Action はキーに割り当てる操作じゃ
+// どのキーでどの操作をするかはキーマップで決めるのじゃ
+type Action int
+
+// 操作の定義
+const (
+	ActionNone        Action = iota // 何も割り当てられていないキーじゃ
+	ActionUp                        // カーソルを上に動かすのじゃ
+	ActionDown                      // カーソルを下に動かすのじゃ
+	ActionLeft                      // カーソルを左に動かすのじゃ
+	ActionRight                     // カーソルを右に動かすのじゃ
+	ActionConfirm                   // 選んでいるものに決めるのじゃ
+	ActionCancel                    // 画面を閉じる、またはポーズメニューを開くのじゃ
+	ActionQuit                      // ランを中断データに残してゲームを終了するのじゃ
+	ActionEndTurn                   // ターンを終えるのじゃ
+	ActionSkip                      // 報酬を飛ばす、または店を出るのじゃ
+	ActionPotion                    // ポーションの選択に切り替えるのじゃ
+	ActionViewDeck                  // デッキを見るのじゃ
+	ActionViewDraw                  // 山札を見るのじゃ
+	ActionViewDiscard               // 捨て札を見るのじゃ
+	ActionViewExhaust               // 廃棄札を見るのじゃ
+	ActionSort                      // 一覧の並べ方を変えるのじゃ
+	ActionHistory                   // ラン履歴を見るのじゃ
+	ActionUnlocks                   // 解放状況を見るのじゃ
+	ActionInspect                   // 選んでいるものを詳しく見るのじゃ
+	ActionLogUp                     // 戦闘ログを古い方へ動かすのじゃ
+	ActionLogDown                   // 戦闘ログを新しい方へ動かすのじゃ
+	ActionExportLog                 // 戦闘ログを書き出すのじゃ
+	ActionCard1                     // 手札の1枚目から10枚目を直接使うのじゃ
+	ActionCard2
+	ActionCard3
+	ActionCard4
+	ActionCard5
+	ActionCard6
+	ActionCard7
+	ActionCard8
+	ActionCard9
+	ActionCard10
+)
+
+// actionNames は設定ファイルで使う操作の名前じゃ
+var actionNames = map[Action]string{
+	ActionUp:          "up",
+	ActionDown:        "down",
+	ActionLeft:        "left",
+	ActionRight:       "right",
+	ActionConfirm:     "confirm",
+	ActionCancel:      "cancel",
+	ActionQuit:        "quit",
+	ActionEndTurn:     "end_turn",
+	ActionSkip:        "skip",
+	ActionPotion:      "potion",
+	ActionViewDeck:    "view_deck",
+	ActionViewDraw:    "view_draw",
+	ActionViewDiscard: "view_discard",
+	ActionViewExhaust: "view_exhaust",
+	ActionSort:        "sort",
+	ActionHistory:     "history",
+	ActionUnlocks:     "unlocks",
+	ActionInspect:     "inspect",
+	ActionLogUp:       "log_up",
+	ActionLogDown:     "log_down",
+	ActionExportLog:   "export_log",
+	ActionCard1:       "card1",
+	ActionCard2:       "card2",
+	ActionCard3:       "card3",
+	ActionCard4:       "card4",
+	ActionCard5:       "card5",
+	ActionCard6:       "card6",
+	ActionCard7:       "card7",
+	ActionCard8:       "card8",
+	ActionCard9:       "card9",
+	ActionCard10:      "card10",
+}
+
+// String は操作の名前を返すのじゃ
+func (a Action) String() string {
+	if name, ok := actionNames[a]; ok {
+		return name
+	}
+	return "none"
+}
+
+// ParseAction は名前から操作を求めるのじゃ
+func ParseAction(name string) (Action, bool) {
+	for action, n := range actionNames {
+		if n == name {
+			return action, true
+		}
+	}
+	return ActionNone, false
+}
+
+// CardIndex は手札を直接使う操作なら手札の番号を返すのじゃ
+func (a Action) CardIndex() (int, bool) {
+	if a >= ActionCard1 && a <= ActionCard10 {
+		return int(a - ActionCard1), true
+	}
+	return -1, false
+}
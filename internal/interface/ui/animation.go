@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// AnimationSpeed はアニメーションの速さの設定じゃ
+type AnimationSpeed int
+
+// アニメーションの速さの定義
+const (
+	AnimationNormal AnimationSpeed = iota // 普通の速さで動かすのじゃ
+	AnimationFast                         // 半分の時間で動かすのじゃ
+	AnimationOff                          // 動かさずにすぐ結果を見せるのじゃ
+	animationSpeedCount
+)
+
+// animationSpeedNames は設定で使うアニメーションの速さの名前じゃ
+var animationSpeedNames = map[AnimationSpeed]string{
+	AnimationNormal: "normal",
+	AnimationFast:   "fast",
+	AnimationOff:    "off",
+}
+
+// animationSpeedLabels は画面に表示するアニメーションの速さの名前のキーじゃ
+var animationSpeedLabels = map[AnimationSpeed]string{
+	AnimationNormal: "animation.normal",
+	AnimationFast:   "animation.fast",
+	AnimationOff:    "animation.off",
+}
+
+// String はアニメーションの速さの名前を返すのじゃ
+func (s AnimationSpeed) String() string {
+	return animationSpeedNames[s]
+}
+
+// Label は画面に表示するアニメーションの速さの名前を返すのじゃ
+func (s AnimationSpeed) Label() string {
+	return i18n.T(animationSpeedLabels[s])
+}
+
+// ParseAnimationSpeed は名前からアニメーションの速さを求めるのじゃ
+func ParseAnimationSpeed(name string) (AnimationSpeed, error) {
+	for speed, n := range animationSpeedNames {
+		if n == name {
+			return speed, nil
+		}
+	}
+	return AnimationNormal, errors.New(i18n.T("error.unknown_animation", name, AnimationSpeedNames()))
+}
+
+// AnimationSpeedNames はアニメーションの速さの名前を返すのじゃ
+func AnimationSpeedNames() []string {
+	names := make([]string, 0, len(animationSpeedNames))
+	for speed := AnimationSpeed(0); speed < animationSpeedCount; speed++ {
+		names = append(names, speed.String())
+	}
+	return names
+}
+
+// frames は普通の速さでのフレーム数を、この速さでのフレーム数に直すのじゃ
+func (s AnimationSpeed) frames(n int) int {
+	switch s {
+	case AnimationFast:
+		return max(1, n/2)
+	case AnimationOff:
+		return 0
+	default:
+		return n
+	}
+}
+
+// アニメーションの長さじゃ。描画1回を1フレームとして数えるのじゃ
+const (
+	floaterFrames   = 40 // ダメージなどの数字が浮かんで消えるまでじゃ
+	flashFrames     = 12 // 攻撃を受けた敵が光っている間じゃ
+	tweenFrames     = 6  // 体力の表示が実際の値に追いつくまでのおおよその長さじゃ
+	turnPauseFrames = 30 // 敵のターンの段階の間の間じゃ
+)
+
+// combatant は戦闘に加わっている者じゃ
+type combatant int
+
+// 戦闘に加わっている者の定義
+const (
+	combatPlayer combatant = iota
+	combatEnemy
+)
+
+// combatNumbers は表示に関わる戦闘中の数値じゃ
+type combatNumbers struct {
+	health [2]int
+	block  [2]int
+}
+
+// floater は戦闘中に浮かんで消える数字じゃ
+type floater struct {
+	target combatant
+	text   string
+	style  Style
+	age    int
+	life   int
+}
+
+// animator は戦闘のアニメーションの状態を保持するのじゃ
+// 描画のたびに1フレーム進め、前のフレームとの数値の違いから動きを作るのじゃ
+type animator struct {
+	speed AnimationSpeed
+	// 見ている敵じゃ。敵が替わったら最初からやり直すのじゃ
+	enemy *entities.Enemy
+	// 前のフレームの数値じゃ
+	last combatNumbers
+	// 画面に表示している体力じゃ。実際の値に少しずつ近づけるのじゃ
+	shown    [2]int
+	floaters []floater
+	flash    int
+	// 敵のターンのまだ見せていない段階と、次の段階までの残りのフレーム数じゃ
+	steps []func()
+	wait  int
+	// 敵のターンの段階の間を短くするかどうかじゃ
+	fastTurns bool
+}
+
+// newAnimator はanimatorのインスタンスを生成するのじゃ
+func newAnimator(speed AnimationSpeed) *animator {
+	return &animator{speed: speed}
+}
+
+// numbersOf はプレイヤーと敵の今の数値を取り出すのじゃ
+func numbersOf(player *entities.Player, enemy *entities.Enemy) combatNumbers {
+	return combatNumbers{
+		health: [2]int{player.Health, enemy.Health},
+		block:  [2]int{player.Block, enemy.Block},
+	}
+}
+
+// reset は今の数値から動きを始め直すのじゃ
+func (a *animator) reset(player *entities.Player, enemy *entities.Enemy) {
+	a.enemy = enemy
+	a.last = numbersOf(player, enemy)
+	a.shown = a.last.health
+	a.floaters = nil
+	a.flash = 0
+}
+
+// tick はアニメーションを1フレーム進めるのじゃ
+// 数値が変わっていたら浮かぶ数字を出し、敵のターンの段階の間を数えるのじゃ
+func (a *animator) tick(player *entities.Player, enemy *entities.Enemy) {
+	if enemy != a.enemy || a.speed == AnimationOff {
+		a.reset(player, enemy)
+	}
+
+	// 浮かんでいる数字を動かし、消える時間になったものは取り除くのじゃ
+	floaters := a.floaters[:0]
+	for _, f := range a.floaters {
+		if f.age++; f.age < f.life {
+			floaters = append(floaters, f)
+		}
+	}
+	a.floaters = floaters
+	a.flash = max(0, a.flash-1)
+
+	current := numbersOf(player, enemy)
+	for _, target := range []combatant{combatPlayer, combatEnemy} {
+		a.spawnFloaters(target, current)
+
+		// 表示している体力を実際の値に近づけるのじゃ
+		diff := current.health[target] - a.shown[target]
+		step := max(1, abs(diff)/max(1, a.speed.frames(tweenFrames)))
+		switch {
+		case diff > 0:
+			a.shown[target] += min(diff, step)
+		case diff < 0:
+			a.shown[target] -= min(-diff, step)
+		}
+	}
+	a.last = current
+
+	// 間を置いてから敵のターンの次の段階に進むのじゃ
+	if len(a.steps) > 0 {
+		if a.wait--; a.wait <= 0 {
+			a.advance()
+		}
+	}
+}
+
+// spawnFloaters は前のフレームからの体力とブロックの違いを浮かぶ数字にするのじゃ
+func (a *animator) spawnFloaters(target combatant, current combatNumbers) {
+	spawn := func(text string, style Style) {
+		// 同じフレームで出た数字は重ならないように少しずらすのじゃ
+		offset := 0
+		for _, f := range a.floaters {
+			if f.target == target && f.age <= 0 {
+				offset++
+			}
+		}
+		a.floaters = append(a.floaters, floater{target: target, text: text, style: style, age: -offset * 4, life: a.speed.frames(floaterFrames)})
+	}
+
+	if diff := current.health[target] - a.last.health[target]; diff < 0 {
+		spawn(fmt.Sprintf("%d", diff), StyleOf(DamageStyleType))
+		if target == combatEnemy {
+			a.flash = a.speed.frames(flashFrames)
+		}
+	} else if diff > 0 {
+		spawn(fmt.Sprintf("+%d", diff), StyleOf(HPStyleType))
+	}
+
+	if diff := current.block[target] - a.last.block[target]; diff != 0 {
+		spawn(i18n.T("animation.block", diff), StyleOf(BlockStyleType))
+	}
+}
+
+// play は敵のターンの段階を間を置きながら順に進めるのじゃ
+// アニメーションが無ければすぐに全て進めるのじゃ
+func (a *animator) play(steps ...func()) {
+	a.steps = append(a.steps, steps...)
+	if a.speed == AnimationOff {
+		a.finish()
+		return
+	}
+	a.wait = a.turnPause()
+}
+
+// turnPause は敵のターンの段階の間のフレーム数を返すのじゃ
+func (a *animator) turnPause() int {
+	if a.fastTurns {
+		return a.speed.frames(turnPauseFrames) / 3
+	}
+	return a.speed.frames(turnPauseFrames)
+}
+
+// advance は敵のターンの次の段階に進むのじゃ
+func (a *animator) advance() {
+	step := a.steps[0]
+	a.steps = a.steps[1:]
+	a.wait = a.turnPause()
+	step()
+}
+
+// finish は敵のターンの残りの段階を待たずに全て進めるのじゃ
+func (a *animator) finish() {
+	for len(a.steps) > 0 {
+		a.advance()
+	}
+}
+
+// active はまだ動きが残っているかどうかを返すのじゃ
+// 浮かぶ数字や体力の表示が追いついておらず、見せていない数値の変化があるときもそうじゃ
+func (a *animator) active(player *entities.Player, enemy *entities.Enemy) bool {
+	if a.speed == AnimationOff {
+		return a.busy()
+	}
+	return a.busy() || len(a.floaters) > 0 || a.flash > 0 || enemy != a.enemy ||
+		a.shown != a.last.health || numbersOf(player, enemy) != a.last
+}
+
+// busy は敵のターンを見せている途中かどうかを返すのじゃ
+func (a *animator) busy() bool {
+	return len(a.steps) > 0
+}
+
+// floatersOf は指定された者の上に浮かんでいる数字を、古い順に返すのじゃ
+func (a *animator) floatersOf(target combatant) []floater {
+	floaters := []floater{}
+	for _, f := range a.floaters {
+		if f.target == target && f.age >= 0 {
+			floaters = append(floaters, f)
+		}
+	}
+	sort.SliceStable(floaters, func(i, j int) bool { return floaters[i].age > floaters[j].age })
+	return floaters
+}
+
+// drawFloaters は浮かんでいる数字を描画するのじゃ
+// 時間が経つほど上に昇り、同じ行に重なったものは右にずらすのじゃ
+func (c *GameController) drawFloaters(target combatant, x, y int) {
+	used := map[int]int{}
+	for _, f := range c.animator.floatersOf(target) {
+		row := y - f.age*3/max(1, f.life)
+		c.screen.DrawText(x+used[row], row, f.style, f.text)
+		used[row] += runewidth.StringWidth(f.text) + 1
+	}
+}
+
+// abs は絶対値を返すのじゃ
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
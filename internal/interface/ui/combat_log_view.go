@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// combatLogWidth は戦闘画面の右側に出す戦闘ログの欄の幅を求めるのじゃ
+func combatLogWidth(width int) int {
+	return min(36, max(26, width/4))
+}
+
+// logLine は戦闘ログの欄に描く1行じゃ
+type logLine struct {
+	style Style
+	text  string
+}
+
+// logEntryStyle は戦闘ログの出来事の種類に合わせたスタイルを返すのじゃ
+func logEntryStyle(kind entities.LogKind) Style {
+	switch kind {
+	case entities.LogCombat, entities.LogTurn:
+		return DisabledStyle()
+	case entities.LogDamage:
+		return StyleOf(DamageStyleType)
+	case entities.LogBlock:
+		return StyleOf(BlockStyleType)
+	case entities.LogHeal:
+		return StyleOf(HPStyleType)
+	case entities.LogPower:
+		return StyleOf(BuffStyleType)
+	case entities.LogCardPlayed:
+		return StyleOf(EnergyStyleType)
+	default:
+		return DefaultStyle()
+	}
+}
+
+// combatLogLines は戦闘ログを欄の幅で折り返した行にするのじゃ
+// ログの細かさで見せないことにした出来事は省くのじゃ
+func combatLogLines(log *entities.CombatLog, width int, verbosity entities.LogVerbosity) []logLine {
+	lines := []logLine{}
+	if log == nil {
+		return lines
+	}
+	for _, entry := range log.Entries {
+		if !verbosity.Shows(entry.Kind) {
+			continue
+		}
+		style := logEntryStyle(entry.Kind)
+		switch entry.Kind {
+		case entities.LogCombat, entities.LogTurn:
+			lines = append(lines, logLine{style, truncateText("─ "+entry.Text.String()+" ─", width)})
+		default:
+			for _, text := range wrapText(entry.Text.String(), width) {
+				lines = append(lines, logLine{style, text})
+			}
+		}
+	}
+	return lines
+}
+
+// scrollCombatLog は戦闘ログの欄を上か下に動かすのじゃ
+// 一番下にいるときは新しい出来事が起きるたびに一緒に流れるのじゃ
+func (c *GameController) scrollCombatLog(action Action) {
+	switch action {
+	case ActionLogUp:
+		c.logScroll++
+	case ActionLogDown:
+		c.logScroll = max(0, c.logScroll-1)
+	}
+}
+
+// 戦闘ログの欄を描画する関数じゃ
+// 新しい出来事が下に来るように並べ、スクロールした分だけ古い出来事を表示するのじゃ
+func (c *GameController) drawCombatLog(r Rect) {
+	title := i18n.T("log.title", c.keymap.Label(ActionLogUp, ActionLogDown))
+	if c.logScroll > 0 {
+		title = i18n.T("log.title_scrolled", c.keymap.Label(ActionLogUp, ActionLogDown), c.logScroll)
+	}
+	inner := c.drawPanel(r, title, DefaultStyle())
+	lines := combatLogLines(c.gameInteractor.CombatLog, inner.Width, c.settings.LogVerbosity)
+
+	// 古すぎるところまでは動かせないようにするのじゃ
+	c.logScroll = max(0, min(c.logScroll, len(lines)-inner.Height))
+	end := len(lines) - c.logScroll
+	start := max(0, end-inner.Height)
+	for row, line := range lines[start:end] {
+		c.drawLabel(inner.Row(row), AlignLeft, line.style, line.text)
+	}
+}
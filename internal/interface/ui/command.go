@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// CommandKind は行単位で遊ぶときの命令の種類じゃ
+type CommandKind int
+
+// 命令の種類の定義
+const (
+	CommandLook   CommandKind = iota // 今の様子をもう一度伝えるのじゃ
+	CommandHelp                      // 使える命令を伝えるのじゃ
+	CommandPlay                      // 手札のカードを使うのじゃ
+	CommandPotion                    // ポーションを使うのじゃ
+	CommandEnd                       // ターンを終えるのじゃ
+	CommandMap                       // マップで次に進むノードを選ぶのじゃ
+	CommandPick                      // 一覧の選択肢を選ぶのじゃ
+	CommandSkip                      // 報酬を飛ばす、または店を出るのじゃ
+	CommandPile                      // カードの束の中身を伝えるのじゃ
+	CommandQuit                      // ランを中断する、またはゲームを終了するのじゃ
+)
+
+// Command は行単位で遊ぶときに打ち込まれた命令じゃ
+// 番号は画面に出す1から始まる番号ではなく、0から始まる番号で持つのじゃ
+type Command struct {
+	Kind   CommandKind
+	Index  int // 使うカードやポーション、選ぶ選択肢の番号じゃ
+	Target int // カードやポーションを使う相手の番号じゃ
+	pile   pileKind
+}
+
+// commandVerbs は命令の言葉と種類の対応じゃ
+// 読み上げやスクリプトで扱いやすいよう、言葉は言語によらず英語にするのじゃ
+var commandVerbs = map[string]CommandKind{
+	"look":    CommandLook,
+	"l":       CommandLook,
+	"help":    CommandHelp,
+	"?":       CommandHelp,
+	"play":    CommandPlay,
+	"p":       CommandPlay,
+	"potion":  CommandPotion,
+	"end":     CommandEnd,
+	"e":       CommandEnd,
+	"map":     CommandMap,
+	"go":      CommandMap,
+	"pick":    CommandPick,
+	"choose":  CommandPick,
+	"skip":    CommandSkip,
+	"leave":   CommandSkip,
+	"deck":    CommandPile,
+	"draw":    CommandPile,
+	"discard": CommandPile,
+	"exhaust": CommandPile,
+	"quit":    CommandQuit,
+	"q":       CommandQuit,
+}
+
+// commandPiles はカードの束の命令の言葉と束の種類の対応じゃ
+var commandPiles = map[string]pileKind{
+	"deck":    pileDeck,
+	"draw":    pileDraw,
+	"discard": pileDiscard,
+	"exhaust": pileExhaust,
+}
+
+// ParseCommand は打ち込まれた1行を命令にするのじゃ
+// 数字だけの行は選択肢を選ぶ命令とみなすのじゃ
+func ParseCommand(line string) (Command, error) {
+	words := strings.Fields(strings.ToLower(line))
+	if len(words) == 0 {
+		return Command{Kind: CommandLook}, nil
+	}
+	if _, err := strconv.Atoi(words[0]); err == nil {
+		words = append([]string{"pick"}, words...)
+	}
+
+	kind, ok := commandVerbs[words[0]]
+	if !ok {
+		return Command{}, errors.New(i18n.T("error.command_unknown", words[0]))
+	}
+	command := Command{Kind: kind, pile: commandPiles[words[0]]}
+	args := words[1:]
+
+	switch kind {
+	case CommandPlay, CommandPotion, CommandMap, CommandPick:
+		if len(args) == 0 {
+			return Command{}, errors.New(i18n.T("error.command_number", words[0]))
+		}
+		index, err := parseCommandNumber(args[0])
+		if err != nil {
+			return Command{}, err
+		}
+		command.Index = index
+		args = args[1:]
+
+		// カードとポーションは「on 番号」で使う相手を選べるのじゃ
+		if kind == CommandPlay || kind == CommandPotion {
+			if len(args) >= 2 && args[0] == "on" {
+				if command.Target, err = parseCommandNumber(args[1]); err != nil {
+					return Command{}, err
+				}
+				args = args[2:]
+			}
+		}
+	}
+
+	if len(args) > 0 {
+		return Command{}, errors.New(i18n.T("error.command_extra", strings.Join(args, " ")))
+	}
+	return command, nil
+}
+
+// parseCommandNumber は1から始まる番号を読み、0から始まる番号にするのじゃ
+func parseCommandNumber(word string) (int, error) {
+	number, err := strconv.Atoi(word)
+	if err != nil || number < 1 {
+		return 0, errors.New(i18n.T("error.command_not_number", word))
+	}
+	return number - 1, nil
+}
+
+// commandHelp は使える命令の説明を返すのじゃ
+func commandHelp() []string {
+	return []string{
+		i18n.T("line.help.title"),
+		i18n.T("line.help.look"),
+		i18n.T("line.help.play"),
+		i18n.T("line.help.potion"),
+		i18n.T("line.help.end"),
+		i18n.T("line.help.map"),
+		i18n.T("line.help.pick"),
+		i18n.T("line.help.skip"),
+		i18n.T("line.help.piles"),
+		i18n.T("line.help.quit"),
+	}
+}
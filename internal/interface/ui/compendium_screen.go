@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// compendiumEntry は図鑑の1行分の内容じゃ
+type compendiumEntry struct {
+	heading     bool // 見出しの行かどうかじゃ
+	name        string
+	description string
+	locked      bool
+	subject     inspectSubject // 詳細の表示で見せる内容じゃ
+}
+
+// compendiumScreen は図鑑の画面の状態を保持するのじゃ
+type compendiumScreen struct {
+	entries []compendiumEntry
+	err     error
+}
+
+// カード色ごとの見出しのキーじゃ
+var cardColorHeadings = []struct {
+	color   entities.CardColor
+	heading string
+}{
+	{entities.Red, "compendium.cards_red"},
+	{entities.Green, "compendium.cards_green"},
+	{entities.Colorless, "compendium.cards_colorless"},
+}
+
+// openCompendiumScreen は図鑑の画面を開くのじゃ
+// まだ解放されていないカードやレリックは名前を伏せるのじゃ
+func (c *GameController) openCompendiumScreen() {
+	profile, err := c.profileInteractor.GetProfile()
+
+	entries := []compendiumEntry{}
+	for _, group := range cardColorHeadings {
+		entries = append(entries, compendiumEntry{heading: true, name: i18n.T(group.heading)})
+		for _, card := range entities.AllCards() {
+			if card.Color != group.color {
+				continue
+			}
+			entries = append(entries, compendiumEntry{
+				name:        i18n.T("card.name_with_cost", card.Name, card.EnergyCost),
+				description: card.Description.String(),
+				locked:      !profile.IsCardUnlocked(card.ID),
+				subject:     cardSubject(card, nil, nil),
+			})
+		}
+	}
+
+	entries = append(entries, compendiumEntry{heading: true, name: i18n.T("compendium.relics")})
+	for _, relic := range entities.AllRelics() {
+		entries = append(entries, compendiumEntry{
+			name:        relic.Name.String(),
+			description: relic.Description.String(),
+			locked:      !profile.IsRelicUnlocked(relic.ID),
+			subject:     relicSubject(relic),
+		})
+	}
+
+	entries = append(entries, compendiumEntry{heading: true, name: i18n.T("compendium.potions")})
+	for _, potion := range entities.AllPotions() {
+		entries = append(entries, compendiumEntry{name: potion.Name.String(), description: potion.Description.String(), subject: potionSubject(potion)})
+	}
+
+	c.menu.compendium = &compendiumScreen{entries: entries, err: err}
+	c.cursorPosition = 0
+}
+
+// handleCompendiumEvents は図鑑の画面のイベントを処理するのじゃ
+// 解放済みの項目は決定か詳細の操作で詳しく見られるのじゃ
+func (c *GameController) handleCompendiumEvents(action Action) {
+	if action != ActionConfirm && action != ActionInspect {
+		return
+	}
+
+	// 見出しと未解放の項目を除いて、選んでいる項目から切り替えられるようにするのじゃ
+	subjects, start := []inspectSubject{}, -1
+	for i, entry := range c.menu.compendium.entries {
+		if entry.heading || entry.locked {
+			continue
+		}
+		if i == c.cursorPosition {
+			start = len(subjects)
+		}
+		subjects = append(subjects, entry.subject)
+	}
+	if start >= 0 {
+		c.openInspectScreen(subjects, start)
+	}
+}
+
+// 図鑑の画面を描画する関数じゃ
+func (c *GameController) drawCompendiumScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("compendium.title"), "")
+	c.drawLabel(area.Row(-1).Inset(1, 0), AlignLeft, DefaultStyle(), i18n.T("help.compendium", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm, ActionInspect), c.keymap.Label(ActionCancel)))
+
+	// 見出しには印を付け、未解放の項目は名前を伏せるのじゃ
+	entries := c.menu.compendium.entries
+	items := make([]listItem, 0, len(entries))
+	for _, entry := range entries {
+		text := "  " + entry.name
+		if entry.heading {
+			text = "■ " + entry.name
+		} else if entry.locked {
+			text = i18n.T("compendium.locked")
+		}
+		items = append(items, listItem{text: text})
+	}
+	list, description := body.Inset(4, 0).SplitTop(body.Height - 1)
+	c.drawList(list, items, AlignLeft, regionOption)
+
+	// 選択中の項目の説明を表示するのじゃ
+	if c.cursorPosition < len(entries) {
+		if entry := entries[c.cursorPosition]; !entry.heading && !entry.locked {
+			c.drawLabel(description, AlignLeft, DefaultStyle(), entry.description)
+		}
+	}
+
+	if c.menu.compendium.err != nil {
+		c.drawNotice(area, i18n.T("error.profile_load", c.menu.compendium.err))
+	}
+}
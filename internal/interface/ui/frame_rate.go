@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"errors"
+	"time"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// baseFrameRate はアニメーションの長さを数えるときの1秒あたりのフレーム数じゃ
+// 描画の上限がこれより低ければ、1回の描画でその分だけアニメーションを進めるのじゃ
+const baseFrameRate = 60
+
+// frameRates は選べる描画の上限じゃ。1秒あたりの回数で、baseFrameRateを割り切れるものだけにするのじゃ
+var frameRates = []int{15, 30, 60}
+
+// FrameRates は選べる描画の上限を返すのじゃ
+func FrameRates() []int {
+	return append([]int{}, frameRates...)
+}
+
+// checkFrameRate は描画の上限が選べるものかを確かめるのじゃ
+func checkFrameRate(rate int) error {
+	for _, r := range frameRates {
+		if r == rate {
+			return nil
+		}
+	}
+	return errors.New(i18n.T("error.unknown_frame_rate", rate, frameRates))
+}
+
+// frameInterval は描画の上限から、描画と描画の間を最低どれだけ空けるかを返すのじゃ
+func frameInterval(rate int) time.Duration {
+	return time.Second / time.Duration(rate)
+}
+
+// animationTicks は1回の描画で進めるアニメーションのフレーム数を返すのじゃ
+func animationTicks(rate int) int {
+	return max(1, baseFrameRate/rate)
+}
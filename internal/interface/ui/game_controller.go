@@ -2,121 +2,349 @@ package ui
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
 	"github.com/yanosea/cts/internal/usecase"
 )
 
 // GameController はゲームの入出力を制御するのじゃ
 type GameController struct {
-	screen         ScreenPort
-	gameInteractor *usecase.GameInteractor
+	screen            ScreenPort
+	menuInteractor    *usecase.MenuInteractor
+	gameInteractor    *usecase.GameInteractor // メニューにいるときはnilじゃ
+	historyInteractor *usecase.HistoryInteractor
+	profileInteractor *usecase.ProfileInteractor
+	// 操作に割り当てたキーじゃ。操作説明に表示するのに使うのじゃ
+	keymap Keymap
+	// 今の設定と、その保存先とキーマップの読み込み方じゃ
+	settings           entities.Settings
+	settingsInteractor *usecase.SettingsInteractor
+	loadKeymap         KeymapLoader
+	// 設定を当てはめるか保存するのに失敗したときのエラーじゃ
+	settingsErr error
+	// ターン終了を確かめているかどうかじゃ
+	endTurnPrompt bool
+	// メニューの状態じゃ
+	menu menuState
+	// 履歴画面を開いているときの状態じゃ
+	historyScreen *historyScreen
+	// 解放状況の画面を開いているときの状態じゃ
+	unlocksScreen *unlocksScreen
+	// カードの束の一覧を開いているときの状態じゃ
+	pileScreen *pileScreen
+	// 詳細の表示を開いているときの状態じゃ
+	inspectScreen *inspectScreen
+	// ランの途中でポーズメニューを開いているときの状態じゃ
+	pauseScreen *pauseScreen
+	// 戦闘ログを一番下から何行さかのぼって表示しているかじゃ
+	logScroll int
+	// 戦闘ログを書き出した結果の知らせじゃ
+	logExportMessage string
+	// 報酬画面の状態じゃ
+	reward rewardState
+	// 戦闘のアニメーションの状態じゃ
+	animator *animator
+	// 戦闘中にポーションを選んでいるかどうかじゃ
+	potionMode bool
+	// 行単位で遊ぶときに伝えた様子じゃ
+	narration narration
+	// マウスでカードを掴んで、使う相手を選んでいるかどうかじゃ
+	targeting bool
+	// 描画したもののうちクリックできる場所じゃ。描画のたびに作り直すのじゃ
+	regions []hitRegion
 	// カーソル位置を保存する変数を追加
 	cursorPosition int
-	// カーソル行の最大値（選択肢の数など）
-	cursorMaxPosition int
+	// メニューで終了を選んだかどうかじゃ
+	quit bool
+	// 前に描画してから見た目が変わったかもしれないかどうかじゃ
+	dirty bool
 }
 
 // NewGameController はGameControllerのインスタンスを生成するのじゃ
-func NewGameController(screen ScreenPort, gameInteractor *usecase.GameInteractor) *GameController {
-	return &GameController{
-		screen:         screen,
-		gameInteractor: gameInteractor,
-		cursorPosition: 0,
-		cursorMaxPosition: 0,
+func NewGameController(screen ScreenPort, menuInteractor *usecase.MenuInteractor, historyInteractor *usecase.HistoryInteractor, profileInteractor *usecase.ProfileInteractor, keymap Keymap) *GameController {
+	c := &GameController{
+		screen:            screen,
+		menuInteractor:    menuInteractor,
+		gameInteractor:    nil,
+		historyInteractor: historyInteractor,
+		profileInteractor: profileInteractor,
+		keymap:            keymap,
+		settings:          entities.NewSettings(),
+		animator:          newAnimator(AnimationNormal),
+		cursorPosition:    0,
+		dirty:             true,
 	}
+	c.refreshMenu()
+	return c
+}
+
+// StartRun はメニューを通さずにランを始めるのじゃ
+func (c *GameController) StartRun(run *usecase.GameInteractor) {
+	c.gameInteractor = run
+	c.cursorPosition = 0
 }
 
 // StartGame はゲームを開始するのじゃ
+// メニューで終了を選ぶまで続くのじゃ
+// 行単位で遊ぶスクリーンなら描画はせず、命令を読んでは様子を伝えることを繰り返すのじゃ
 func (c *GameController) StartGame() {
-	// 最初の戦闘を開始するのじゃ
-	c.gameInteractor.StartNewCombat()
-
-	// イベント処理を別のゴルーチンで実行するのじゃ
-	go func() {
-		for !c.gameInteractor.IsDone() {
-			c.handleEvents()
+	if narrator, ok := c.screen.(NarratorPort); ok {
+		narrator.Narrate(c.describe())
+		for !c.quit {
+			c.handleEvent(c.screen.PollEvent())
 		}
-	}()
+		return
+	}
+
+	// 入力を待つのだけは別のゴルーチンに任せ、届いたイベントをチャネルで受け取るのじゃ
+	// 状態を変えるのも描画するのもこのループだけなので、排他は要らないのじゃ
+	events := make(chan EventPort)
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go c.pollEvents(events, stop, stopped)
 
 	// ゲームループじゃ
-	for !c.gameInteractor.IsDone() {
-		c.draw()
-		c.screen.Sleep(16) // 約60FPSじゃ
+	// 入力があったときとアニメーションが動いている間だけ描画し、何も無ければ入力を待つのじゃ
+	// 描画と描画の間は、設定した上限の回数から決まる間隔より詰めないのじゃ
+	var lastFrame time.Time
+	var frame <-chan time.Time
+	for !c.quit {
+		if frame == nil && c.needsRedraw() {
+			frame = time.After(time.Until(lastFrame.Add(frameInterval(c.settings.FrameRate))))
+		}
+		select {
+		case event := <-events:
+			c.handleEvent(event)
+		case <-frame:
+			frame = nil
+			lastFrame = time.Now()
+			c.draw()
+		}
 	}
+
+	// 入力を待っているゴルーチンを起こし、止まるのを待ってから戻るのじゃ
+	close(stop)
+	c.screen.Interrupt()
+	<-stopped
 }
 
-// handleEvents はイベントを処理する関数じゃ
-func (c *GameController) handleEvents() {
-	event := c.screen.PollEvent()
+// pollEvents は入力を待ってはチャネルに送ることを、止めるよう言われるまで繰り返すのじゃ
+// ゲームの状態には触れないのじゃ
+func (c *GameController) pollEvents(events chan<- EventPort, stop <-chan struct{}, stopped chan<- struct{}) {
+	defer close(stopped)
+	for {
+		event := c.screen.PollEvent()
+		select {
+		case events <- event:
+		case <-stop:
+			return
+		}
+	}
+}
 
-	// ESCキーまたはCtrl+Cでゲーム終了
-	if event.IsExit() {
-		c.gameInteractor.SetDone(true)
+// currentState は現在の場面を返すのじゃ。ランを遊んでいなければメニューじゃ
+func (c *GameController) currentState() entities.GameState {
+	if c.gameInteractor == nil {
+		return entities.StateMenu
+	}
+	return c.gameInteractor.State
+}
+
+// leaveRun はランを終えてメニューに戻るのじゃ
+func (c *GameController) leaveRun() {
+	c.gameInteractor = nil
+	c.historyScreen = nil
+	c.unlocksScreen = nil
+	c.pileScreen = nil
+	c.inspectScreen = nil
+	c.pauseScreen = nil
+	c.reward = rewardState{}
+	c.logScroll = 0
+	c.logExportMessage = ""
+	c.animator.steps = nil
+	c.potionMode = false
+	c.targeting = false
+	c.endTurnPrompt = false
+	c.cursorPosition = 0
+	c.refreshMenu()
+}
+
+// handleEvent は1つのイベントを処理する関数じゃ
+// キーはキーマップで操作に置き換えてから扱うのじゃ
+func (c *GameController) handleEvent(event EventPort) {
+	action := event.Action()
+
+	// 状態はイベントでしか変わらないので、どのイベントの後も描き直すのじゃ
+	c.dirty = true
+
+	// 行単位で打ち込まれた命令はそちらで処理するのじゃ
+	if command, ok := event.Command(); ok {
+		c.handleCommand(command)
+		return
+	}
+
+	// 終了の操作はどの画面にいてもランを残してゲームを終えるのじゃ
+	if action == ActionQuit {
+		c.interrupt()
 		return
 	}
 
-	// リサイズイベントの処理
+	// ターン終了を確かめているときは、決定かターン終了の操作でターンを終え、他の操作で取りやめるのじゃ
+	if c.endTurnPrompt && (event.IsAnyKey() || event.IsClick()) {
+		c.endTurnPrompt = false
+		if action == ActionConfirm || action == ActionEndTurn {
+			c.endTurn()
+		}
+		return
+	}
+
+	// リサイズイベントは描き直すだけじゃ
 	if event.IsResize() {
-		c.screen.Show()
 		return
 	}
 
+	// 取り消しの操作で開いている画面を閉じるか、ポーズメニューを開くのじゃ
+	if action == ActionCancel {
+		c.cancel()
+		return
+	}
+
+	// ポーズメニューを開いているときはそちらで処理するのじゃ
+	if c.pauseScreen != nil {
+		if event.IsMouse() {
+			c.handleMouseEvents(event)
+		} else {
+			c.handlePauseEvents(action)
+		}
+		return
+	}
+
+	// 履歴画面を開いているときはそちらで処理するのじゃ
+	if c.historyScreen != nil {
+		c.handleHistoryEvents(action)
+		return
+	}
+
+	// 解放状況の画面を開いているときはそちらで処理するのじゃ
+	if c.unlocksScreen != nil {
+		c.handleUnlocksEvents(action)
+		return
+	}
+
+	// 詳細の表示を開いているときはそちらで処理するのじゃ
+	if c.inspectScreen != nil {
+		c.handleInspectEvents(action)
+		return
+	}
+
+	// カードの束の一覧を開いているときはそちらで処理するのじゃ
+	if c.pileScreen != nil {
+		c.handlePileEvents(action)
+		return
+	}
+
+	// 選んでいるものの詳細を開くのじゃ。メニューの図鑑ではそちらで開くのじゃ
+	if action == ActionInspect && c.gameInteractor != nil {
+		c.openInspectScreen(c.inspectSubjects())
+		return
+	}
+
+	// ホットキーでカードの束の一覧を開くのじゃ
+	if kind, ok := c.pileHotkey(action); ok && c.canViewPiles() {
+		c.openPileScreen(kind)
+		return
+	}
+
+	// マウスの操作は描画したときに登録した場所で判定するのじゃ
+	if event.IsMouse() {
+		c.handleMouseEvents(event)
+		return
+	}
+
+	// キーで操作したらマウスで掴んだカードは離すのじゃ
+	c.targeting = false
+
 	// カーソル移動の処理
-	c.handleCursorMovement(event)
+	c.handleCursorMovement(action)
+
+	switch c.currentState() {
+	case 0: // StateMenu
+		c.handleMenuEvents(action)
 
-	switch c.gameInteractor.State {
 	case 1: // StateMap
 		// マップ選択画面ではカーソルでノードを選択するのじゃ
-		if event.IsEnter() || event.IsSpace() {
-			if c.cursorPosition >= 0 && c.cursorPosition < len(c.gameInteractor.GameMap.CurrentNode.Connections) {
-				c.gameInteractor.SelectMapNode(c.gameInteractor.GameMap.CurrentNode.Connections[c.cursorPosition])
-				c.cursorPosition = 0 // カーソルをリセット
-			}
+		if action == ActionConfirm {
+			c.confirmSelection()
 		}
 
 	case 2: // StateCombat
-		// カーソルでカードを選択し、ENTERまたはSPACEでカード使用
-		if event.IsEnter() || event.IsSpace() {
-			if c.cursorPosition >= 0 && c.cursorPosition < len(c.gameInteractor.Player.Hand) {
-				c.gameInteractor.UseCard(c.cursorPosition)
+		// 戦闘ログの欄をスクロールするのじゃ
+		if action == ActionLogUp || action == ActionLogDown {
+			c.scrollCombatLog(action)
+			return
+		}
+
+		// 敵のターンを見せている間は、決定かターン終了の操作で最後まで飛ばすのじゃ
+		if c.animator.busy() {
+			if action == ActionConfirm || action == ActionEndTurn {
+				c.animator.finish()
 			}
+			return
 		}
 
-		// eキーまたはスペースでターン終了するのじゃ
-		if event.IsEndTurn() {
-			c.gameInteractor.EndTurn()
+		// ポーションの選択に切り替えるのじゃ
+		if action == ActionPotion {
+			c.potionMode = !c.potionMode && len(c.gameInteractor.Player.Potions) > 0
+			c.cursorPosition = 0
+			return
 		}
 
-	case 3: // StateReward
-		// カーソルでカード報酬を選択するのじゃ
-		if event.IsEnter() || event.IsSpace() {
-			if c.cursorPosition >= 0 && c.cursorPosition < len(c.gameInteractor.CardRewards) {
-				c.gameInteractor.SelectCardReward(c.cursorPosition)
-				c.cursorPosition = 0 // カーソルをリセット
+		// 数字のキーで手札のカードを直接使うのじゃ
+		if index, ok := action.CardIndex(); ok {
+			if index < len(c.gameInteractor.Player.Hand) {
+				c.potionMode = false
+				c.cursorPosition = index
+				c.confirmSelection()
 			}
+			return
 		}
 
-		// sキーで報酬をスキップするのじゃ
-		if event.IsSKey() {
-			c.gameInteractor.SkipCardReward()
-			c.cursorPosition = 0 // カーソルをリセット
+		// ポーション選択中は決定でポーション使用
+		if c.potionMode {
+			if action == ActionConfirm {
+				c.confirmSelection()
+			}
+			return
+		}
+
+		// カーソルでカードを選択し、決定でカード使用、ターン終了の操作でターンを終えるのじゃ
+		switch action {
+		case ActionConfirm:
+			c.confirmSelection()
+		case ActionEndTurn:
+			c.requestEndTurn()
 		}
 
+	case 3: // StateReward
+		// カーソルで報酬を選んで受け取り、飛ばす操作で捨てるのじゃ
+		c.handleRewardEvents(action)
+
 	case 4: // StateRest
 		// カーソル位置で選択肢を決定
-		if event.IsEnter() || event.IsSpace() {
-			if c.cursorPosition == 0 {
-				c.gameInteractor.RestHeal()
-			} else if c.cursorPosition == 1 {
-				c.gameInteractor.RestUpgrade()
-			}
-			c.cursorPosition = 0 // カーソルをリセット
+		if action == ActionConfirm {
+			c.confirmSelection()
 		}
 
 	case 5: // StateShop
-		// ショップ機能はまだ未実装
-		// 何かキーを押すとマップに戻る
-		if event.IsAnyKey() {
+		// カーソルで商品を選択して購入し、飛ばす操作で店を出てマップに戻るのじゃ
+		switch action {
+		case ActionConfirm:
+			c.confirmSelection()
+		case ActionSkip:
 			c.gameInteractor.ReturnToMap()
 			c.cursorPosition = 0 // カーソルをリセット
 		}
@@ -130,52 +358,297 @@ func (c *GameController) handleEvents() {
 		}
 
 	case 7: // StateGameOver
-		// 何かキーを押すとゲームを終了するのじゃ
+		// ラン履歴の画面を開くのじゃ
+		if action == ActionHistory {
+			c.openHistoryScreen()
+			return
+		}
+
+		// 解放状況の画面を開くのじゃ
+		if action == ActionUnlocks {
+			c.openUnlocksScreen()
+			return
+		}
+
+		// このランの戦闘ログをファイルに書き出すのじゃ
+		if action == ActionExportLog {
+			if path, err := c.gameInteractor.ExportCombatLog(); err != nil {
+				c.logExportMessage = err.Error()
+			} else {
+				c.logExportMessage = i18n.T("gameover.log_exported", path)
+			}
+			return
+		}
+
+		// 何かキーを押すとメニューに戻るのじゃ
 		if event.IsAnyKey() {
 			c.gameInteractor.SetDone(true)
 		}
+
+	case 8: // StateCharacterSelect
+		// カーソルでキャラクターを選び、決定でランを始めるのじゃ
+		if action == ActionConfirm {
+			c.confirmSelection()
+		}
+	}
+
+	// ランが終わったらメニューに戻るのじゃ
+	if c.gameInteractor != nil && c.gameInteractor.IsDone() {
+		c.leaveRun()
 	}
 }
 
+// cancel は開いている画面を閉じるのじゃ
+// ランの途中で何も開いていなければポーズメニューを開き、ゲームオーバーならメニューに戻るのじゃ
+// メニューで何も開いていなければゲームを終了するのじゃ
+func (c *GameController) cancel() {
+	if c.gameInteractor == nil {
+		if !c.closeMenuScreen() {
+			c.quit = true
+		}
+		return
+	}
+
+	switch {
+	case c.pauseScreen != nil:
+		c.backPauseScreen()
+	case c.inspectScreen != nil:
+		c.inspectScreen = nil
+	case c.pileScreen != nil:
+		c.closePileScreen()
+	case c.historyScreen != nil:
+		c.closeHistoryScreen()
+	case c.unlocksScreen != nil:
+		c.unlocksScreen = nil
+	case c.reward.choosing || c.reward.confirmLeave:
+		c.backRewardScreen()
+	case c.currentState() == entities.StateGameOver:
+		c.gameInteractor.SetDone(true)
+		c.leaveRun()
+	default:
+		c.openPauseScreen()
+	}
+}
+
+// interrupt はランを中断データに残してゲームを終了するのじゃ
+// 中断データは部屋に入るときとマップに戻るときに保存済みなので、次はそこから再開するのじゃ
+func (c *GameController) interrupt() {
+	if c.gameInteractor != nil {
+		c.gameInteractor.SuspendRun()
+	}
+	c.quit = true
+}
+
 // カーソル移動を処理する関数じゃ
-func (c *GameController) handleCursorMovement(event EventPort) {
+func (c *GameController) handleCursorMovement(action Action) {
+	// 戦闘中の手札とポーションは横に並んでいるので左右で選ぶのじゃ
+	if c.currentState() == entities.StateCombat {
+		c.moveCursor(action, ActionLeft, ActionRight)
+		return
+	}
+
 	// カーソル操作 - 上下移動
-	if event.IsUp() && c.cursorPosition > 0 {
+	c.moveCursor(action, ActionUp, ActionDown)
+}
+
+// moveCursor はprevの操作でカーソルを1つ前に、nextの操作で1つ後ろに動かすのじゃ
+func (c *GameController) moveCursor(action, prev, next Action) {
+	if action == prev && c.cursorPosition > 0 {
 		c.cursorPosition--
-	} else if event.IsDown() && c.cursorPosition < c.cursorMaxPosition-1 {
+	} else if action == next && c.cursorPosition < c.cursorLimit()-1 {
 		c.cursorPosition++
 	}
 }
 
+// cursorLimit は今の画面でカーソルを置ける項目の数を返すのじゃ
+// 描画を待たずに続けて届いた入力でも今の画面に合わせて動かせるよう、描画した結果ではなく今の状態から数えるのじゃ
+// 開いている画面はイベントを処理するのと同じ順に確かめるのじゃ
+func (c *GameController) cursorLimit() int {
+	switch {
+	case c.pauseScreen != nil && c.pauseScreen.settings:
+		return len(c.settingItems())
+	case c.pauseScreen != nil:
+		return len(c.pauseChoices())
+	case c.historyScreen != nil:
+		return len(c.historyScreen.records)
+	case c.unlocksScreen != nil, c.inspectScreen != nil:
+		return 0
+	case c.pileScreen != nil:
+		return len(c.pileCards())
+	}
+
+	switch c.currentState() {
+	case 0: // StateMenu
+		switch {
+		case c.menu.setup != nil:
+			return len(c.setupItems())
+		case c.menu.compendium != nil:
+			return len(c.menu.compendium.entries)
+		case c.menu.settings:
+			return len(c.settingItems())
+		}
+		return len(c.menuItems())
+	case 1: // StateMap
+		return len(c.gameInteractor.GameMap.CurrentNode.Connections)
+	case 2: // StateCombat
+		if c.potionMode {
+			return len(c.gameInteractor.Player.Potions)
+		}
+		return len(c.gameInteractor.Player.Hand)
+	case 3: // StateReward
+		return len(c.rewardChoices())
+	case 4: // StateRest
+		return len(c.restItems())
+	case 5: // StateShop
+		return len(c.gameInteractor.Shop.Items)
+	case 8: // StateCharacterSelect
+		return len(entities.AllCharacters())
+	}
+	return 0
+}
+
+// requestEndTurn はターンを終える操作を受けるのじゃ
+// まだ使えるカードが残っていれば、設定に従って先に確かめるのじゃ
+func (c *GameController) requestEndTurn() {
+	if c.needsEndTurnConfirm() {
+		c.endTurnPrompt = true
+		return
+	}
+	c.endTurn()
+}
+
+// needsEndTurnConfirm はターン終了を確かめるべきかを返すのじゃ
+// エナジーが残っていて、そのエナジーで使えるカードが手札にあるときじゃ
+func (c *GameController) needsEndTurnConfirm() bool {
+	player := c.gameInteractor.Player
+	if !c.settings.ConfirmEndTurn || player.Energy <= 0 {
+		return false
+	}
+	for _, card := range player.Hand {
+		if !card.Unplayable && player.GetCardCost(card) <= player.Energy {
+			return true
+		}
+	}
+	return false
+}
+
+// endTurn はターンを終え、敵のターンを間を置きながら段階ごとに見せるのじゃ
+func (c *GameController) endTurn() {
+	c.endTurnPrompt = false
+	c.targeting = false
+	c.gameInteractor.EndPlayerTurn()
+	c.animator.play(c.gameInteractor.PerformEnemyTurn, c.gameInteractor.StartPlayerTurn)
+}
+
+// advanceAnimation はアニメーションを1フレーム進めるのじゃ
+// 戦闘が終わっていたら、見せ終わっていない敵のターンの段階を全て進めるのじゃ
+func (c *GameController) advanceAnimation() {
+	if c.currentState() != entities.StateCombat {
+		c.animator.finish()
+		return
+	}
+	c.animator.tick(c.gameInteractor.Player, c.gameInteractor.Enemy)
+}
+
+// needsRedraw は描き直すべきかを返すのじゃ
+// 前の描画から何か起きたか、戦闘のアニメーションが動いている間じゃ
+func (c *GameController) needsRedraw() bool {
+	if c.dirty {
+		return true
+	}
+	return c.currentState() == entities.StateCombat && c.pauseScreen == nil &&
+		c.animator.active(c.gameInteractor.Player, c.gameInteractor.Enemy)
+}
+
 // draw は画面を描画する関数じゃ
 func (c *GameController) draw() {
+	c.dirty = false
+
+	// 描画の上限に合わせてアニメーションを進めるのじゃ
+	// ポーズメニューを開いている間は止めておくのじゃ
+	if c.pauseScreen == nil {
+		for i := 0; i < animationTicks(c.settings.FrameRate); i++ {
+			c.advanceAnimation()
+		}
+	}
+
 	c.screen.Clear()
+	c.regions = c.regions[:0]
 
 	// 画面サイズを確認するのじゃ
-	width, height := c.screen.GetSize()
+	area := c.screenRect()
 
 	// 最小サイズを確認するのじゃ
-	if width < 80 || height < 24 {
-		c.drawSizeWarning(width, height)
+	if area.Width < minScreenWidth || area.Height < minScreenHeight {
+		c.drawSizeWarning(area)
 	} else {
 		// タイトルを表示するのじゃ
-		c.screen.DrawText(1, 1, DefaultStyle(), "Slay the CLI")
+		header := area.Row(1).Inset(1, 0)
+		title := c.drawLabel(header, AlignLeft, DefaultStyle(), "Slay the CLI")
+		_, header = header.SplitLeft(title.Width + 2)
+		if c.gameInteractor == nil {
+			// メニューでは何も載せないのじゃ
+		} else if daily := c.gameInteractor.Daily; daily != nil {
+			c.drawLabel(header, AlignLeft, DefaultStyle(), i18n.T("header.daily", daily.Date))
+		} else {
+			c.drawLabel(header, AlignLeft, DefaultStyle(), i18n.T("header.ascension", c.gameInteractor.Ascension.Level))
+		}
 
-		switch c.gameInteractor.State {
+		if c.historyScreen != nil {
+			c.drawHistoryScreen(area)
+			c.screen.Show()
+			return
+		}
+		if c.unlocksScreen != nil {
+			c.drawUnlocksScreen(area)
+			c.screen.Show()
+			return
+		}
+		if c.pauseScreen != nil && c.pauseScreen.settings {
+			c.drawSettingsScreen(area)
+			c.screen.Show()
+			return
+		}
+
+		switch c.currentState() {
+		case 0: // StateMenu
+			c.drawMenuScreen(area)
 		case 1: // StateMap
-			c.drawMapScreen(width, height)
+			c.drawMapScreen(area)
 		case 2: // StateCombat
-			c.drawCombatScreen(width, height)
+			c.drawCombatScreen(area)
 		case 3: // StateReward
-			c.drawRewardScreen(width, height)
+			c.drawRewardScreen(area)
 		case 4: // StateRest
-			c.drawRestScreen(width, height)
+			c.drawRestScreen(area)
 		case 5: // StateShop
-			c.drawShopScreen(width, height)
+			c.drawShopScreen(area)
 		case 6: // StateEvent
-			c.drawEventScreen(width, height)
+			c.drawEventScreen(area)
 		case 7: // StateGameOver
-			c.drawGameOverScreen(width, height)
+			c.drawGameOverScreen(area)
+		case 8: // StateCharacterSelect
+			c.drawCharacterSelectScreen(area)
+		}
+
+		// カードの束の一覧は元の画面に重ねて描画するのじゃ
+		if c.pileScreen != nil {
+			c.drawPileScreen(area)
+		}
+
+		// 詳細の表示は一番上に重ねて描画するのじゃ
+		if c.inspectScreen != nil {
+			c.drawInspectScreen(area)
+		}
+
+		// ターン終了の確かめも重ねて描画するのじゃ
+		if c.endTurnPrompt {
+			c.drawEndTurnPrompt()
+		}
+
+		// ポーズメニューはランの画面の一番上に重ねて描画するのじゃ
+		if c.pauseScreen != nil {
+			c.drawPauseScreen()
 		}
 	}
 
@@ -183,237 +656,294 @@ func (c *GameController) draw() {
 }
 
 // 画面サイズが小さすぎる場合の警告を表示するのじゃ
-func (c *GameController) drawSizeWarning(width, height int) {
-	centerX := width / 2
-	centerY := height / 2
-
-	warningText := "画面サイズが小さすぎる！"
-	sizeText := fmt.Sprintf("現在: %dx%d 最小: 80x24", width, height)
-
-	c.screen.DrawText(max(0, centerX-len(warningText)/2), centerY-1, DefaultStyle(), warningText)
-	c.screen.DrawText(max(0, centerX-len(sizeText)/2), centerY+1, DefaultStyle(), sizeText)
+func (c *GameController) drawSizeWarning(area Rect) {
+	message := area.Center(area.Width, 3)
+	c.drawLabel(message.Row(0), AlignCenter, DefaultStyle(), i18n.T("size.warning"))
+	c.drawLabel(message.Row(2), AlignCenter, DefaultStyle(), i18n.T("size.current", area.Width, area.Height, minScreenWidth, minScreenHeight))
 }
 
 // 戦闘画面を描画する関数じゃ
-func (c *GameController) drawCombatScreen(width, height int) {
-	centerX := width / 2
-
-	// プレイヤー情報を表示するのじゃ（右側に配置）
-	healthInfo := fmt.Sprintf("体力: %d/%d", c.gameInteractor.Player.Health, c.gameInteractor.Player.MaxHealth)
-	blockInfo := fmt.Sprintf("ブロック: %d", c.gameInteractor.Player.Block)
-	goldInfo := fmt.Sprintf("ゴールド: %d", c.gameInteractor.Player.Gold)
-	energyInfo := fmt.Sprintf("エナジー: %d/%d", c.gameInteractor.Player.Energy, c.gameInteractor.Player.MaxEnergy)
-
-	// プレイヤー情報を画面右側に表示するのじゃ
-	statusX := width - 25
-	c.screen.DrawText(statusX, height-6, DefaultStyle(), healthInfo)
-	c.screen.DrawText(statusX, height-5, DefaultStyle(), blockInfo)
-	c.screen.DrawText(statusX, height-4, DefaultStyle(), goldInfo)
-	c.screen.DrawText(statusX, height-3, DefaultStyle(), energyInfo)
+// 右側には戦闘ログの欄とプレイヤーの情報を出し、それ以外のものは残りの幅に並べるのじゃ
+func (c *GameController) drawCombatScreen(area Rect) {
+	player := c.gameInteractor.Player
+	enemy := c.gameInteractor.Enemy
+
+	// 下の2行はターン終了のボタンと操作説明、右の列は戦闘ログとプレイヤーの情報じゃ
+	footer, upper := area.SplitBottom(2)
+	side, main := upper.SplitRight(combatLogWidth(area.Width))
+	_, side = side.SplitTop(2)
+	_, logArea := side.SplitBottom(5)
+	c.drawCombatLog(logArea)
+	statusColumn, _ := upper.SplitRight(28)
+	statusArea, _ := statusColumn.SplitBottom(5)
+
+	// プレイヤー情報を右下に表示するのじゃ
+	// 体力の棒にブロックを重ね、その下にエナジーとゴールド、状態の欄を並べるのじゃ
+	statusRows := statusArea.Rows(1, 1, 1, 0)
+	c.drawHealthBar(statusRows[0], c.animator.shown[combatPlayer], player.MaxHealth, player.Block)
+	c.drawLabel(statusRows[1], AlignLeft, StyleOf(EnergyStyleType), i18n.T("combat.energy", player.Energy, player.MaxEnergy))
+	c.drawLabel(statusRows[2], AlignLeft, DefaultStyle(), i18n.T("combat.gold", i18n.Number(player.Gold)))
+	enemyStatuses := enemy.Statuses()
+	c.drawStatusRow(statusRows[3], player.Statuses(), len(enemyStatuses))
+	c.drawFloaters(combatPlayer, statusArea.X-16, statusArea.Y+statusArea.Height-1)
 
 	// 敵の情報を表示するのじゃ
-	enemyInfo := fmt.Sprintf("%s (%d/%d)", c.gameInteractor.Enemy.Name, c.gameInteractor.Enemy.Health, c.gameInteractor.Enemy.MaxHealth)
-	enemyBlockInfo := fmt.Sprintf("ブロック: %d", c.gameInteractor.Enemy.Block)
-	enemyIntention := fmt.Sprintf("意図: %s %d", c.gameInteractor.Enemy.Intention, c.gameInteractor.Enemy.Damage)
+	// 名前、ブロックを重ねた体力の棒、意図、状態の欄の順に並べるのじゃ
+	enemyArea := main.Rows(3, 4)[1].CenterColumn(30)
+	enemyRows := enemyArea.Rows(1, 1, 1, 1)
+
+	// 攻撃してくるときは意図をダメージの色で目立たせるのじゃ
+	intentionStyle := DefaultStyle()
+	if enemy.Damage > 0 && enemy.Intention == entities.IntentAttack {
+		intentionStyle = StyleOf(DamageStyleType)
+	}
 
-	c.screen.DrawText(centerX-len(enemyInfo)/2, 3, DefaultStyle(), enemyInfo)
-	c.screen.DrawText(centerX-len(enemyBlockInfo)/2, 4, DefaultStyle(), enemyBlockInfo)
-	c.screen.DrawText(centerX-len(enemyIntention)/2, 5, DefaultStyle(), enemyIntention)
+	// 攻撃を受けた直後の敵はダメージの色で光らせるのじゃ
+	enemyStyle := DefaultStyle()
+	if c.animator.flash > 0 {
+		enemyStyle = StyleOf(DamageStyleType)
+	}
 
-	// 手札を表示するのじゃ（左側に配置）
-	c.screen.DrawText(1, height-12, DefaultStyle(), "手札:")
+	// 敵の情報の辺りをクリックすると、掴んだカードを敵に使うのじゃ
+	// 状態の欄は後から登録して、クリックすると詳細を開くようにするのじゃ
+	c.addRegion(enemyArea, regionEnemy, 0)
+	c.drawLabel(enemyRows[0], AlignLeft, enemyStyle, enemy.Name.String())
+	c.drawHealthBar(enemyRows[1], c.animator.shown[combatEnemy], enemy.MaxHealth, enemy.Block)
+	c.drawLabel(enemyRows[2], AlignLeft, intentionStyle, i18n.T("combat.intent", enemy.Intention.Name(), enemy.Damage))
+	c.drawStatusRow(enemyRows[3], enemyStatuses, 0)
+	c.drawFloaters(combatEnemy, enemyArea.X+enemyArea.Width+2, enemyRows[2].Y)
+	if c.targeting {
+		c.drawLabel(main.Row(7), AlignCenter, DefaultStyle(), i18n.T("combat.target_hint"))
+	} else if c.settings.DamagePreview && !c.potionMode && c.cursorPosition < len(player.Hand) {
+		// 選んでいるカードを使ったときの数値を先に見せるのじゃ
+		c.drawLabel(main.Row(7), AlignCenter, DefaultStyle(), cardPreview(player.Hand[c.cursorPosition], player, enemy))
+	}
 
-	// カーソルの最大位置を設定（手札の枚数）
-	c.cursorMaxPosition = min(len(c.gameInteractor.Player.Hand), 5) // 最大表示枚数を超えないように
+	// 手札の下は空けておき、手札と表示しきれないカードの印、1行空けてポーションの順に積むのじゃ
+	_, rest := main.Inset(1, 0).SplitBottom(4)
+	hand, rest := rest.SplitBottom(cardFrameHeight + 1)
+	_, rest = rest.SplitBottom(1)
+	potions, _ := rest.SplitBottom(1)
 
-	maxCardsToShow := 5 // 最大表示枚数
-	for i, card := range c.gameInteractor.Player.Hand {
-		if i >= maxCardsToShow {
-			break // 最大表示枚数を超えたら表示しないのじゃ
-		}
-		
-		// カードの情報を作成
-		cardInfo := fmt.Sprintf("%s (%dエナジー) - %s", card.Name, card.EnergyCost, card.Description)
-		
-		// カーソル位置に応じてスタイルを変更（背景色のみで選択表示）
-		if i == c.cursorPosition {
-			c.screen.DrawText(1, height-11+i, SelectedStyle(), cardInfo)
-		} else {
-			c.screen.DrawText(1, height-11+i, DefaultStyle(), cardInfo)
-		}
-	}
+	// ポーションを表示するのじゃ
+	c.drawPotions(potions)
 
-	// 手札が多い場合は省略を表示するのじゃ
-	if len(c.gameInteractor.Player.Hand) > maxCardsToShow {
-		c.screen.DrawText(1, height-11+maxCardsToShow, DefaultStyle(), fmt.Sprintf("...他 %d 枚", len(c.gameInteractor.Player.Hand)-maxCardsToShow))
-	}
+	// 手札をカードの枠で横に並べて表示するのじゃ
+	c.drawHand(hand)
 
-	// 山札と捨て札の情報を表示するのじゃ
-	deckInfo := fmt.Sprintf("山札: %d枚 捨て札: %d枚", len(c.gameInteractor.Player.DrawPile), len(c.gameInteractor.Player.DiscardPile))
-	c.screen.DrawText(width-len(deckInfo)-1, height-1, DefaultStyle(), deckInfo)
+	// 山札と捨て札の情報を表示するのじゃ。キーを押すと中身を見られるのじゃ
+	buttons := footer.Row(0).Inset(1, 0)
+	deckInfo := i18n.T("combat.piles",
+		c.keymap.Label(ActionViewDraw), i18n.Plural("count.cards", len(player.DrawPile)), c.keymap.Label(ActionViewDiscard), i18n.Plural("count.cards", len(player.DiscardPile)),
+		c.keymap.Label(ActionViewExhaust), i18n.Plural("count.cards", len(player.ExhaustPile)), c.keymap.Label(ActionViewDeck))
+	c.drawLabel(buttons, AlignRight, DefaultStyle(), deckInfo)
+
+	// クリックでターンを終えるボタンじゃ
+	button := c.drawLabel(buttons, AlignLeft, DefaultStyle(), i18n.T("combat.end_turn_button"))
+	c.addRegion(button, regionEndTurn, 0)
 
 	// 操作説明を表示するのじゃ
-	c.screen.DrawText(1, height-1, DefaultStyle(), "操作: i/,:選択 ;//:決定 e/;:ターン終了 q:終了")
+	helpText := i18n.T("help.combat",
+		c.keymap.Label(ActionLeft, ActionRight), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionCard1), c.keymap.Label(ActionCard10),
+		c.keymap.Label(ActionEndTurn), c.keymap.Label(ActionPotion), c.keymap.Label(ActionInspect), c.keymap.Label(ActionCancel))
+	c.drawLabel(footer.Row(1).Inset(1, 0), AlignLeft, DefaultStyle(), helpText)
 }
 
-// マップ画面を描画する関数じゃ
-func (c *GameController) drawMapScreen(width, height int) {
-	centerX := width / 2
+// drawEndTurnPrompt はまだ使えるカードがあることを知らせ、ターンを終えるか確かめる小窓を描画するのじゃ
+func (c *GameController) drawEndTurnPrompt() {
+	helpText := i18n.T("help.end_turn_prompt", c.keymap.Label(ActionConfirm, ActionEndTurn))
+	inner := c.drawDialog(48, 6, i18n.T("end_turn_prompt.title"), helpText)
+	c.drawParagraph(inner.Inset(2, 1), DefaultStyle(), i18n.T("end_turn_prompt.body", c.gameInteractor.Player.Energy))
+}
 
-	// タイトルを表示
-	mapTitle := "ダンジョンマップ"
-	c.screen.DrawText(centerX-len(mapTitle)/2, 3, DefaultStyle(), mapTitle)
+// ポーションの一覧を1行で描画する関数じゃ
+func (c *GameController) drawPotions(r Rect) {
+	potions := c.gameInteractor.Player.Potions
+	header := c.drawLabel(r, AlignLeft, DefaultStyle(), i18n.T("combat.potions", len(potions), c.gameInteractor.Player.PotionSlots))
 
-	// 現在のフロア情報を表示
-	floorInfo := fmt.Sprintf("現在のフロア: %d", c.gameInteractor.GameMap.CurrentNode.Position.Floor)
-	c.screen.DrawText(centerX-len(floorInfo)/2, 5, DefaultStyle(), floorInfo)
+	_, r = r.SplitLeft(header.Width + 1)
+	for i, potion := range potions {
+		style := DefaultStyle()
+		if c.potionMode && i == c.cursorPosition {
+			style = SelectedStyle()
+		}
+		drawn := c.drawLabel(r, AlignLeft, style, i18n.T("combat.potion", potion.Name, potion.Description))
+		c.addRegion(drawn, regionPotion, i)
+		_, r = r.SplitLeft(drawn.Width + 1)
+	}
+}
 
-	// 接続されたノードを表示
-	c.screen.DrawText(centerX-10, 7, DefaultStyle(), "選択可能なノード:")
+// マップ画面を描画する関数じゃ
+func (c *GameController) drawMapScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("map.title"), i18n.T("help.map", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionViewDeck), c.keymap.Label(ActionInspect), c.keymap.Label(ActionCancel)))
 
-	// カーソルの最大位置を設定（接続ノードの数）
-	c.cursorMaxPosition = len(c.gameInteractor.GameMap.CurrentNode.Connections)
+	// 現在のフロア情報を表示
+	c.drawLabel(body.Row(0), AlignCenter, DefaultStyle(), i18n.T("map.floor", c.gameInteractor.GameMap.CurrentNode.Position.Floor))
 
-	for i, node := range c.gameInteractor.GameMap.CurrentNode.Connections {
-		nodeInfo := fmt.Sprintf("%s (フロア %d)", node.GetNodeTypeString(), node.Position.Floor)
-		
-		// カーソル位置に応じてスタイルを変更（背景色のみで選択表示）
-		if i == c.cursorPosition {
-			c.screen.DrawText(centerX-len(nodeInfo)/2, 9+i, SelectedStyle(), nodeInfo)
-		} else {
-			c.screen.DrawText(centerX-len(nodeInfo)/2, 9+i, DefaultStyle(), nodeInfo)
+	// 接続されたノードを表示
+	c.drawLabel(body.Row(2), AlignCenter, DefaultStyle(), i18n.T("map.choices"))
+	items := []listItem{}
+	for _, node := range c.gameInteractor.GameMap.CurrentNode.Connections {
+		items = append(items, listItem{text: i18n.T("map.node", node.GetNodeTypeName(), node.Position.Floor), style: NodeStyle(node.Type)})
+	}
+	list := body.Rows(4, 0, 3)[1]
+	c.drawList(list, items, AlignCenter, regionOption)
+
+	// デイリーチャレンジの変化を表示
+	if daily := c.gameInteractor.Daily; daily != nil {
+		descriptions := make([]string, 0, len(daily.Modifiers))
+		for _, modifier := range daily.Modifiers {
+			descriptions = append(descriptions, entities.GetDailyModifierDescription(modifier).String())
 		}
+		c.drawLabel(body.Row(-3), AlignCenter, DefaultStyle(), i18n.T("map.modifiers", strings.Join(descriptions, " / ")))
 	}
 
 	// プレイヤー情報を表示
-	playerInfo := fmt.Sprintf("体力: %d/%d  ゴールド: %d", c.gameInteractor.Player.Health, c.gameInteractor.Player.MaxHealth, c.gameInteractor.Player.Gold)
-	c.screen.DrawText(centerX-len(playerInfo)/2, height-5, DefaultStyle(), playerInfo)
+	c.drawLabel(body.Row(-1), AlignCenter, DefaultStyle(), i18n.T("map.player", c.gameInteractor.Player.Health, c.gameInteractor.Player.MaxHealth, i18n.Number(c.gameInteractor.Player.Gold)))
+}
 
-	// 操作説明
-	c.screen.DrawText(centerX-20, height-3, DefaultStyle(), "操作: i/,:選択 ;//:決定 q:終了")
+// restItems は休憩場所で選べる選択肢を返すのじゃ
+func (c *GameController) restItems() []listItem {
+	return []listItem{
+		{text: i18n.T("rest.heal", c.gameInteractor.RestHealPercent())},
+		{text: i18n.T("rest.upgrade")},
+	}
 }
 
 // 休憩場所画面を描画する関数じゃ
-func (c *GameController) drawRestScreen(width, height int) {
-	centerX := width / 2
-
-	// タイトルを表示
-	restTitle := "休憩所"
-	c.screen.DrawText(centerX-len(restTitle)/2, 3, DefaultStyle(), restTitle)
+func (c *GameController) drawRestScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("rest.title"), i18n.T("help.map", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionViewDeck), c.keymap.Label(ActionInspect), c.keymap.Label(ActionCancel)))
 
 	// 選択肢を表示
-	healOption := fmt.Sprintf("回復 (体力の30%%回復)")
-	upgradeOption := "カードアップグレード (未実装)"
-
-	// カーソルの最大位置を設定（選択肢の数）
-	c.cursorMaxPosition = 2
-
-	// カーソル位置に応じてスタイルを変更（背景色のみで選択表示）
-	if c.cursorPosition == 0 {
-		c.screen.DrawText(centerX-len(healOption)/2, height/2-1, SelectedStyle(), healOption)
-	} else {
-		c.screen.DrawText(centerX-len(healOption)/2, height/2-1, DefaultStyle(), healOption)
-	}
-
-	if c.cursorPosition == 1 {
-		c.screen.DrawText(centerX-len(upgradeOption)/2, height/2+1, SelectedStyle(), upgradeOption)
-	} else {
-		c.screen.DrawText(centerX-len(upgradeOption)/2, height/2+1, DefaultStyle(), upgradeOption)
-	}
+	items := c.restItems()
+	c.drawList(body.Center(body.Width, len(items)), items, AlignCenter, regionOption)
 
 	// プレイヤー情報を表示
-	playerInfo := fmt.Sprintf("体力: %d/%d", c.gameInteractor.Player.Health, c.gameInteractor.Player.MaxHealth)
-	c.screen.DrawText(centerX-len(playerInfo)/2, height-5, DefaultStyle(), playerInfo)
-
-	// 操作説明
-	c.screen.DrawText(centerX-20, height-3, DefaultStyle(), "操作: i/,:選択 ;//:決定 q:終了")
+	c.drawLabel(body.Row(-1), AlignCenter, DefaultStyle(), i18n.T("rest.player", c.gameInteractor.Player.Health, c.gameInteractor.Player.MaxHealth))
 }
 
-// ショップ画面を描画する関数じゃ
-func (c *GameController) drawShopScreen(width, height int) {
-	centerX := width / 2
+// キャラクター選択画面を描画する関数じゃ
+// キャラクターごとに名前、説明、初期レリックの3行を並べるのじゃ
+func (c *GameController) drawCharacterSelectScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("character_select.title"), i18n.T("help.character_select", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionCancel)))
+
+	characters := entities.AllCharacters()
+
+	_, body = body.SplitTop(1)
+	body = body.Inset(6, 0)
+	for i, character := range characters {
+		block, rest := body.SplitTop(4)
+		body = rest
+		name := i18n.T("character_select.row", character.Name, character.MaxHealth, i18n.Number(character.Gold))
+		if !c.gameInteractor.IsCharacterUnlocked(character.ID) {
+			name = i18n.T("character_select.locked", character.Name)
+		}
 
-	// タイトルを表示
-	shopTitle := "ショップ"
-	c.screen.DrawText(centerX-len(shopTitle)/2, 3, DefaultStyle(), shopTitle)
+		// カーソル位置に応じてスタイルを変更（背景色のみで選択表示）
+		style := DefaultStyle()
+		if i == c.cursorPosition {
+			style = SelectedStyle()
+		}
+		drawn := c.drawLabel(block.Row(0), AlignLeft, style, name)
+		c.addRegion(drawn, regionOption, i)
 
-	// まだ実装されていないことを表示
-	notImplementedText := "ショップ機能はまだ実装されていません"
-	continueText := "何かキーを押してマップに戻る..."
+		details := block.Inset(2, 0)
+		c.drawLabel(details.Row(1), AlignLeft, DefaultStyle(), character.Description.String())
+		if relic, ok := entities.CreateRelicByID(character.StarterRelic); ok {
+			c.drawLabel(details.Row(2), AlignLeft, DefaultStyle(), i18n.T("character_select.starter_relic", relic.Name, relic.Description))
+		}
+	}
+}
 
-	c.screen.DrawText(centerX-len(notImplementedText)/2, height/2-1, DefaultStyle(), notImplementedText)
-	c.screen.DrawText(centerX-len(continueText)/2, height/2+1, DefaultStyle(), continueText)
+// ショップ画面を描画する関数じゃ
+func (c *GameController) drawShopScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("shop.title"), i18n.T("help.shop", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionSkip), c.keymap.Label(ActionInspect), c.keymap.Label(ActionCancel)))
+
+	// 商品を一覧で表示するのじゃ
+	// 売り切れは暗く、カードはレア度の色で描くのじゃ
+	items := []listItem{}
+	for _, item := range c.gameInteractor.Shop.Items {
+		price := i18n.T("shop.price", item.Price)
+		if item.Sold {
+			price = i18n.T("shop.sold_out")
+		}
+		style := DefaultStyle()
+		if item.Sold {
+			style = DisabledStyle()
+		} else if item.Kind == entities.ShopCard {
+			style = RarityStyle(item.Card.Rarity)
+		}
+		text := fmt.Sprintf("%s %s - %s", runewidth.FillRight(price, 10), runewidth.FillRight(item.GetName().String(), 16), item.GetDescription())
+		items = append(items, listItem{text: text, style: style})
+	}
+	_, body = body.SplitTop(1)
+	list, footer := body.Inset(4, 0).SplitTop(body.Height - 2)
+	c.drawList(list, items, AlignLeft, regionOption)
 
 	// プレイヤーの所持金を表示
-	goldInfo := fmt.Sprintf("所持金: %dゴールド", c.gameInteractor.Player.Gold)
-	c.screen.DrawText(centerX-len(goldInfo)/2, height-5, DefaultStyle(), goldInfo)
+	c.drawLabel(footer.Row(-1), AlignCenter, DefaultStyle(), i18n.T("shop.gold", i18n.Number(c.gameInteractor.Player.Gold)))
 }
 
 // イベント画面を描画する関数じゃ
-func (c *GameController) drawEventScreen(width, height int) {
-	centerX := width / 2
-
-	// タイトルを表示
-	eventTitle := "イベント"
-	c.screen.DrawText(centerX-len(eventTitle)/2, 3, DefaultStyle(), eventTitle)
+func (c *GameController) drawEventScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("event.title"), "")
 
 	// まだ実装されていないことを表示
-	notImplementedText := "イベント機能はまだ実装されていません"
-	continueText := "何かキーを押してマップに戻る..."
-
-	c.screen.DrawText(centerX-len(notImplementedText)/2, height/2-1, DefaultStyle(), notImplementedText)
-	c.screen.DrawText(centerX-len(continueText)/2, height/2+1, DefaultStyle(), continueText)
+	message := body.Center(body.Width, 3)
+	c.drawLabel(message.Row(0), AlignCenter, DefaultStyle(), i18n.T("event.not_implemented"))
+	c.drawLabel(message.Row(2), AlignCenter, DefaultStyle(), i18n.T("event.continue"))
 }
 
-// 報酬画面を描画する関数じゃ
-func (c *GameController) drawRewardScreen(width, height int) {
-	centerX := width / 2
-
-	// 勝利メッセージを表示するのじゃ
-	victoryText := "敵を倒した！"
-	goldText := fmt.Sprintf("報酬: %dゴールド", c.gameInteractor.Player.Gold)
-
-	c.screen.DrawText(centerX-len(victoryText)/2, height/4, DefaultStyle(), victoryText)
-	c.screen.DrawText(centerX-len(goldText)/2, height/4+1, DefaultStyle(), goldText)
-
-	// カード報酬を表示するのじゃ
-	c.screen.DrawText(centerX-5, height/4+3, DefaultStyle(), "カード報酬:")
+// ゲームオーバー画面を描画する関数じゃ
+func (c *GameController) drawGameOverScreen(area Rect) {
+	score := c.gameInteractor.Score
 
-	// カーソルの最大位置を設定（カード報酬の数）
-	c.cursorMaxPosition = len(c.gameInteractor.CardRewards)
+	// ゲームオーバーメッセージを表示するのじゃ
+	gameOverText := i18n.T("gameover.title")
+	if c.gameInteractor.Victory {
+		gameOverText = i18n.T("gameover.victory")
+	}
+	c.drawLabel(area.Row(3), AlignCenter, DefaultStyle(), gameOverText)
 
-	for i, card := range c.gameInteractor.CardRewards {
-		cardInfo := fmt.Sprintf("%s (%dエナジー) - %s", card.Name, card.EnergyCost, card.Description)
-		
-		// カーソル位置に応じてスタイルを変更（背景色のみで選択表示）
-		if i == c.cursorPosition {
-			c.screen.DrawText(centerX-len(cardInfo)/2, height/4+5+i, SelectedStyle(), cardInfo)
-		} else {
-			c.screen.DrawText(centerX-len(cardInfo)/2, height/4+5+i, DefaultStyle(), cardInfo)
-		}
+	// 新しいアセンションが解放されたら知らせるのじゃ
+	if c.gameInteractor.AscensionUnlocked {
+		unlockText := i18n.T("gameover.ascension_unlocked", c.gameInteractor.Ascension.Level+1, entities.GetAscensionDescription(c.gameInteractor.Ascension.Level+1))
+		c.drawLabel(area.Row(4), AlignCenter, DefaultStyle(), unlockText)
 	}
 
-	// 操作説明
-	skipText := "s: スキップ"
-	c.screen.DrawText(centerX-len(skipText)/2, height/4+10, DefaultStyle(), skipText)
-	
-	// 操作説明を追加
-	vimText := "i/,:選択 ;//:決定 q:終了"
-	c.screen.DrawText(centerX-len(vimText)/2, height/4+12, DefaultStyle(), vimText)
-}
+	// スコアの内訳を表示するのじゃ
+	// 内訳の後に1行空けて合計を書くのじゃ
+	scoreArea := Rect{X: area.X, Y: area.Y + 5, Width: area.Width, Height: len(score.Items) + 3}.CenterColumn(40)
+	c.drawLabel(scoreArea.Row(0), AlignLeft, DefaultStyle(), i18n.T("gameover.score"))
+	_, scoreItems := scoreArea.SplitLeft(2)
+	for i, item := range score.Items {
+		itemText := fmt.Sprintf("%s %s %+5d", runewidth.FillRight(item.Name, 16), runewidth.FillRight(item.Detail, 14), item.Points)
+		c.drawLabel(scoreItems.Row(1+i), AlignLeft, DefaultStyle(), itemText)
+	}
+	totalText := fmt.Sprintf("%s %5s", runewidth.FillRight(i18n.T("gameover.total"), 31), i18n.Number(score.Total))
+	c.drawLabel(scoreItems.Row(-1), AlignLeft, DefaultStyle(), totalText)
 
-// ゲームオーバー画面を描画する関数じゃ
-func (c *GameController) drawGameOverScreen(width, height int) {
-	centerX := width / 2
+	// 新しく解放されたカードやレリックを知らせるのじゃ
+	for i, tier := range c.gameInteractor.NewUnlocks {
+		c.drawLabel(area.Row(-8+i).Inset(1, 0), AlignCenter, DefaultStyle(), i18n.T("gameover.new_unlocks", describeUnlockTier(tier)))
+	}
 
-	// ゲームオーバーメッセージを表示するのじゃ
-	gameOverText := "ゲームオーバー"
-	scoreText := fmt.Sprintf("獲得したゴールド: %d", c.gameInteractor.Player.Gold)
-	exitText := "何かキーを押して終了..."
+	if c.logExportMessage != "" {
+		c.drawLabel(area.Row(-6).Inset(1, 0), AlignCenter, DefaultStyle(), c.logExportMessage)
+	}
+	c.drawLabel(area.Row(-5), AlignCenter, DefaultStyle(), i18n.T("help.gameover", c.keymap.Label(ActionHistory), c.keymap.Label(ActionUnlocks), c.keymap.Label(ActionExportLog)))
+	c.drawLabel(area.Row(-4), AlignCenter, DefaultStyle(), i18n.T("gameover.continue"))
 
-	c.screen.DrawText(centerX-len(gameOverText)/2, height/2-1, DefaultStyle(), gameOverText)
-	c.screen.DrawText(centerX-len(scoreText)/2, height/2, DefaultStyle(), scoreText)
-	c.screen.DrawText(centerX-len(exitText)/2, height/2+2, DefaultStyle(), exitText)
+	// 履歴やプロフィールの保存に失敗していたら知らせるのじゃ
+	if c.gameInteractor.LeaderboardError != nil {
+		c.drawLabel(area.Row(-3).Inset(1, 0), AlignLeft, DefaultStyle(), i18n.T("error.leaderboard_save", c.gameInteractor.LeaderboardError))
+	}
+	if c.gameInteractor.HistoryError != nil {
+		c.drawLabel(area.Row(-2).Inset(1, 0), AlignLeft, DefaultStyle(), i18n.T("error.history_save", c.gameInteractor.HistoryError))
+	}
+	if c.gameInteractor.ProfileError != nil {
+		c.drawLabel(area.Row(-1).Inset(1, 0), AlignLeft, DefaultStyle(), i18n.T("error.profile_save", c.gameInteractor.ProfileError))
+	}
 }
 
 // 2つの整数の最大値を返す関数じゃ
@@ -0,0 +1,302 @@
+package ui_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/infrastructure/memory_screen"
+	"github.com/yanosea/cts/internal/interface/ui"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// update を付けて動かすと、ゴールデンファイルを今の画面で書き直すのじゃ
+var update = flag.Bool("update", false, "ゴールデンファイルを今の画面で書き直すのじゃ")
+
+// goldenSeed はランを作るときの乱数の種じゃ。同じ種なら同じマップと敵になるのじゃ
+const goldenSeed = 1
+
+// goldenCase は台本どおりに遊んで、最後に見せた画面をゴールデンファイルと見比べる1つの場面じゃ
+type goldenCase struct {
+	name string
+	// 台本を終えたときにいるはずの場面じゃ
+	state entities.GameState
+	// run ならランを始めてから台本を進めるのじゃ。キャラクターが空ならキャラクター選択から始まるのじゃ
+	run       bool
+	character string
+	// setup は台本を進める前にランを整えるのじゃ
+	setup  func(run *usecase.GameInteractor)
+	script []ui.EventPort
+}
+
+// goldenCases は見比べる場面の一覧じゃ。どの場面も1つ以上含めるのじゃ
+var goldenCases = []goldenCase{
+	{
+		name:  "menu",
+		state: entities.StateMenu,
+	},
+	{
+		name:   "menu_settings",
+		state:  entities.StateMenu,
+		script: memory_screen.Keys(ui.ActionDown, ui.ActionDown, ui.ActionDown, ui.ActionDown, ui.ActionConfirm, ui.ActionDown),
+	},
+	{
+		name:  "character_select",
+		state: entities.StateCharacterSelect,
+		run:   true,
+	},
+	{
+		name:      "combat",
+		state:     entities.StateCombat,
+		run:       true,
+		character: "ironclad",
+		script:    memory_screen.Keys(ui.ActionRight),
+	},
+	{
+		name:      "combat_pause",
+		state:     entities.StateCombat,
+		run:       true,
+		character: "ironclad",
+		script:    memory_screen.Keys(ui.ActionCancel),
+	},
+	{
+		name:      "combat_end_turn_prompt",
+		state:     entities.StateCombat,
+		run:       true,
+		character: "ironclad",
+		script:    memory_screen.Keys(ui.ActionEndTurn),
+	},
+	{
+		name:      "reward",
+		state:     entities.StateReward,
+		run:       true,
+		character: "ironclad",
+		setup:     weakenEnemyFor(0),
+		script:    memory_screen.Keys(ui.ActionConfirm),
+	},
+	{
+		// 手札の3枚目で倒しても、報酬の一覧は先頭から選ぶのじゃ
+		name:      "reward_after_third_card",
+		state:     entities.StateReward,
+		run:       true,
+		character: "ironclad",
+		setup:     weakenEnemyFor(2),
+		script:    memory_screen.Keys(ui.ActionRight, ui.ActionRight, ui.ActionConfirm),
+	},
+	{
+		name:      "map",
+		state:     entities.StateMap,
+		run:       true,
+		character: "ironclad",
+		setup:     winCombat,
+		script:    memory_screen.Keys(ui.ActionDown),
+	},
+	{
+		// マップで決めてすぐに手札を選んでも、描画を待たずに手札の枚数までカーソルを動かせるのじゃ
+		name:      "combat_keys_before_redraw",
+		state:     entities.StateCombat,
+		run:       true,
+		character: "ironclad",
+		setup:     nextNodes(entities.NodeEnemy),
+		script:    memory_screen.Rapid(memory_screen.Key(ui.ActionConfirm), memory_screen.Key(ui.ActionRight), memory_screen.Key(ui.ActionRight), memory_screen.Key(ui.ActionRight)),
+	},
+	{
+		name:      "rest",
+		state:     entities.StateRest,
+		run:       true,
+		character: "ironclad",
+		setup:     nextNodes(entities.NodeRest),
+		script:    memory_screen.Keys(ui.ActionConfirm),
+	},
+	{
+		name:      "shop",
+		state:     entities.StateShop,
+		run:       true,
+		character: "ironclad",
+		setup:     nextNodes(entities.NodeShop),
+		script:    memory_screen.Keys(ui.ActionConfirm),
+	},
+	{
+		name:      "event",
+		state:     entities.StateEvent,
+		run:       true,
+		character: "ironclad",
+		setup:     nextNodes(entities.NodeEvent),
+		script:    memory_screen.Keys(ui.ActionConfirm),
+	},
+	{
+		name:      "game_over",
+		state:     entities.StateGameOver,
+		run:       true,
+		character: "ironclad",
+		setup: func(run *usecase.GameInteractor) {
+			run.Enemy.NextAction = func(e *entities.Enemy, p *entities.Player) {
+				e.Intention = entities.IntentAttack
+				e.Damage = p.Health + p.Block
+				p.ApplyDamage(e.Damage)
+			}
+		},
+		// 使えるカードが残っているので、ターン終了を確かめられてからもう一度押すのじゃ
+		script: memory_screen.Keys(ui.ActionEndTurn, ui.ActionEndTurn),
+	},
+}
+
+// weakenEnemyFor は最初の戦闘の敵を、手札のindex番目のカード1枚で倒せるようにするのじゃ
+func weakenEnemyFor(index int) func(run *usecase.GameInteractor) {
+	return func(run *usecase.GameInteractor) {
+		run.Enemy.Health = 1
+		run.Enemy.Block = 0
+		run.Player.Hand[index] = entities.CreateStrikeCard()
+	}
+}
+
+// winCombat は最初の戦闘に勝ち、報酬を受け取らずにマップに戻るのじゃ
+func winCombat(run *usecase.GameInteractor) {
+	weakenEnemyFor(0)(run)
+	run.UseCard(0)
+	run.LeaveRewards()
+}
+
+// nextNodes は最初の戦闘に勝ってから、次に進めるノードを全て指定された種類にするのじゃ
+func nextNodes(nodeType entities.NodeType) func(run *usecase.GameInteractor) {
+	return func(run *usecase.GameInteractor) {
+		winCombat(run)
+		for _, node := range run.GameMap.CurrentNode.Connections {
+			node.Type = nodeType
+		}
+	}
+}
+
+// play は場面の台本どおりに遊んで、最後に見せた画面を返すのじゃ
+// 本物と同じゲームループで動かし、アニメーションは止めておくのじゃ
+func play(t *testing.T, gc goldenCase) (*memory_screen.ScreenAdapter, entities.GameState) {
+	t.Helper()
+	i18n.SetLang(i18n.Japanese)
+
+	screen := memory_screen.NewScreenAdapter(80, 24, gc.script...)
+	keymap, _ := ui.KeymapPreset("arrow")
+	controller := ui.NewGameController(screen, usecase.NewMenuInteractor(usecase.Repositories{}, "golden"), nil, nil, keymap)
+	settings := entities.NewSettings()
+	settings.Language = string(i18n.Japanese)
+	settings.Animation = ui.AnimationOff.String()
+	if err := controller.UseSettings(nil, nil, settings); err != nil {
+		t.Fatalf("設定を当てはめられなかったのじゃ: %v", err)
+	}
+
+	var run *usecase.GameInteractor
+	if gc.run {
+		run = usecase.NewGameInteractor(usecase.RunOptions{Seed: goldenSeed, Character: gc.character, PlayerName: "golden"}, usecase.Repositories{})
+		if gc.setup != nil {
+			gc.setup(run)
+		}
+		controller.StartRun(run)
+	}
+	controller.StartGame()
+
+	if run == nil {
+		return screen, entities.StateMenu
+	}
+	return screen, run.State
+}
+
+// TestGolden は場面ごとに台本どおりに遊び、最後の画面の文字と色の種類をゴールデンファイルと見比べるのじゃ
+// go test -run TestGolden -update で書き直せるのじゃ
+func TestGolden(t *testing.T) {
+	for _, gc := range goldenCases {
+		t.Run(gc.name, func(t *testing.T) {
+			screen, state := play(t, gc)
+			if state != gc.state {
+				t.Fatalf("場面が %d ではなく %d になったのじゃ", gc.state, state)
+			}
+
+			path := filepath.Join("testdata", "golden", gc.name+".golden")
+			got := screen.Snapshot()
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("ゴールデンファイルを読めなかったのじゃ。-update で作るのじゃ: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("画面が %s と違うのじゃ\n--- 今の画面 ---\n%s", path, got)
+			}
+		})
+	}
+}
+
+// TestGoldenCoversEveryState はどの場面にもゴールデンファイルがあるかを確かめるのじゃ
+func TestGoldenCoversEveryState(t *testing.T) {
+	covered := map[entities.GameState]bool{}
+	for _, gc := range goldenCases {
+		covered[gc.state] = true
+	}
+	for state := entities.StateMenu; state <= entities.StateCharacterSelect; state++ {
+		if !covered[state] {
+			t.Errorf("場面 %d のゴールデンファイルが無いのじゃ", state)
+		}
+	}
+}
+
+// burstCase は描画を待たずに続けて届く入力を試す1つの場面じゃ
+type burstCase struct {
+	name  string
+	setup func(run *usecase.GameInteractor)
+	// before は1つずつ描き直しながら進め、burst は描画を待たずに続けて送る操作じゃ
+	before []ui.Action
+	burst  []ui.Action
+}
+
+// burstCases は描画の間に入力が重なっても、1つずつ描き直したときと同じ結果になるはずの場面じゃ
+var burstCases = []burstCase{
+	{
+		// マップで決めた後の手札の選択じゃ
+		name:  "map_to_combat",
+		setup: nextNodes(entities.NodeEnemy),
+		burst: []ui.Action{ui.ActionConfirm, ui.ActionRight, ui.ActionRight, ui.ActionRight, ui.ActionRight},
+	},
+	{
+		// 報酬を残して進むかの2択を描いた後で、3つあるノードを選ぶのじゃ
+		name:   "reward_to_map",
+		setup:  weakenEnemyFor(0),
+		before: []ui.Action{ui.ActionConfirm, ui.ActionDown, ui.ActionDown, ui.ActionConfirm, ui.ActionDown},
+		burst:  []ui.Action{ui.ActionConfirm, ui.ActionDown, ui.ActionDown},
+	},
+	{
+		// 5枚の手札を描いた後で、7枚ある山札の一覧を選ぶのじゃ
+		name:   "combat_to_pile",
+		before: []ui.Action{ui.ActionRight},
+		burst:  []ui.Action{ui.ActionViewDraw, ui.ActionDown, ui.ActionDown, ui.ActionDown, ui.ActionDown, ui.ActionDown, ui.ActionDown},
+	},
+}
+
+// TestEventsFasterThanFrames は描画の間隔より速く届いた入力が、1つずつ描き直したときと同じカーソルと選択になるかを確かめるのじゃ
+func TestEventsFasterThanFrames(t *testing.T) {
+	for _, bc := range burstCases {
+		t.Run(bc.name, func(t *testing.T) {
+			actions := append(append([]ui.Action{}, bc.before...), bc.burst...)
+			gc := goldenCase{run: true, character: "ironclad", setup: bc.setup, script: memory_screen.Keys(actions...)}
+			want, wantState := play(t, gc)
+
+			gc.script = append(memory_screen.Keys(bc.before...), memory_screen.Rapid(memory_screen.Keys(bc.burst...)...)...)
+			got, gotState := play(t, gc)
+
+			if gotState != wantState {
+				t.Fatalf("場面が %d ではなく %d になったのじゃ", wantState, gotState)
+			}
+			if got.Snapshot() != want.Snapshot() {
+				t.Errorf("描き直す前に続けて入力すると画面が変わるのじゃ\n--- 1つずつ描き直したとき ---\n%s\n--- 続けて入力したとき ---\n%s", want.Snapshot(), got.Snapshot())
+			}
+		})
+	}
+}
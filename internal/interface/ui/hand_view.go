@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// 手札のカードの枠の大きさじゃ
+const (
+	cardFrameWidth  = 14
+	cardFrameHeight = 7
+	// 重ねて並べるときでも見えている幅の下限じゃ。これより狭くなるならスクロールするのじゃ
+	minCardStep = 5
+)
+
+// handLayout は手札を横に並べるときの配置じゃ
+type handLayout struct {
+	first int // 表示する最初のカードの番号じゃ
+	count int // 表示するカードの枚数じゃ
+	step  int // 隣のカードとの間隔じゃ
+}
+
+// layoutHand は手札を表示幅に収まるように並べる配置を求めるのじゃ
+// 並べきれないときは重ねて詰め、それでも足りなければカーソルの周りだけを表示するのじゃ
+func layoutHand(handSize, cursor, width int) handLayout {
+	if handSize == 0 {
+		return handLayout{}
+	}
+
+	// 間を1つ空けて並べられるならそのまま並べるのじゃ
+	if handSize*(cardFrameWidth+1) <= width+1 {
+		return handLayout{first: 0, count: handSize, step: cardFrameWidth + 1}
+	}
+
+	// 重ねて詰めれば収まるなら詰めるのじゃ
+	if handSize > 1 {
+		if step := (width - cardFrameWidth) / (handSize - 1); step >= minCardStep {
+			return handLayout{first: 0, count: handSize, step: step}
+		}
+	}
+
+	// 収まらなければカーソルが見える範囲だけを表示するのじゃ
+	count := max(1, (width-cardFrameWidth)/minCardStep+1)
+	first := max(0, min(cursor-count/2, handSize-count))
+	return handLayout{first: first, count: count, step: minCardStep}
+}
+
+// 手札をカードの枠で横に並べて描画する関数じゃ
+// 詰めて並べるときは選択中のカードだけを全て見せ、他のカードは左端だけを見せるのじゃ
+func (c *GameController) drawHand(r Rect) {
+	hand := c.gameInteractor.Player.Hand
+	layout := layoutHand(len(hand), c.cursorPosition, r.Width)
+	cards, more := r.SplitTop(cardFrameHeight)
+
+	// 全て見せるカードじゃ。何も選んでいなければ右端のカードにするのじゃ
+	expanded := layout.first + layout.count - 1
+	if !c.potionMode && c.cursorPosition >= layout.first && c.cursorPosition < layout.first+layout.count {
+		expanded = c.cursorPosition
+	}
+
+	cardX := cards.X
+	for i := layout.first; i < layout.first+layout.count; i++ {
+		frameWidth := min(layout.step, cardFrameWidth)
+		if i == expanded {
+			frameWidth = cardFrameWidth
+		}
+		selected := i == c.cursorPosition && !c.potionMode
+		frame := Rect{X: cardX, Y: cards.Y, Width: frameWidth, Height: cardFrameHeight}
+		c.drawCardFrame(frame, hand[i], c.cardStyle(hand[i], selected))
+		c.addRegion(frame, regionHandCard, i)
+
+		if i == expanded {
+			cardX += cardFrameWidth + layout.step - min(layout.step, cardFrameWidth)
+		} else {
+			cardX += layout.step
+		}
+	}
+
+	// 右端のカードを切り落としていたら枠を閉じるのじゃ
+	if last := layout.first + layout.count - 1; last >= 0 && last != expanded && layout.step < cardFrameWidth {
+		style := c.cardStyle(hand[last], last == c.cursorPosition && !c.potionMode)
+		edge := Rect{X: cardX, Y: cards.Y, Width: 1, Height: cardFrameHeight}
+		c.drawLabel(edge.Row(0), AlignLeft, style, "┐")
+		for row := 1; row < cardFrameHeight-1; row++ {
+			c.drawLabel(edge.Row(row), AlignLeft, style, "│")
+		}
+		c.drawLabel(edge.Row(-1), AlignLeft, style, "┘")
+	}
+
+	// 表示しきれないカードがあれば印を付けるのじゃ
+	if layout.first > 0 {
+		c.drawLabel(more.Row(0), AlignLeft, DefaultStyle(), i18n.T("hand.more_left", i18n.Plural("count.cards", layout.first)))
+	}
+	if rest := len(hand) - layout.first - layout.count; rest > 0 {
+		c.drawLabel(more.Row(0), AlignRight, DefaultStyle(), i18n.T("hand.more_right", i18n.Plural("count.cards", rest)))
+	}
+}
+
+// cardStyle は手札のカードを描くスタイルを返すのじゃ
+// 選択中なら強調し、エナジーが足りないカードや使えないカードは暗くするのじゃ
+// それ以外はカードの種類の色で描くのじゃ
+func (c *GameController) cardStyle(card entities.Card, selected bool) Style {
+	player := c.gameInteractor.Player
+	switch {
+	case selected:
+		return SelectedStyle()
+	case card.Unplayable || player.GetCardCost(card) > player.Energy:
+		return DisabledStyle()
+	default:
+		return CardTypeStyle(card.Type)
+	}
+}
+
+// カードを枠付きで描画する関数じゃ
+// 1行目にコストと名前、2行目に種類、残りに効果の説明を書くのじゃ
+// 幅が枠より狭ければ、右側を切り落として左端だけを描き、収まらない文字は…で切り詰めるのじゃ
+func (c *GameController) drawCardFrame(r Rect, card entities.Card, style Style) {
+	inner := cardFrameWidth - 2
+	clipped := r.Width < cardFrameWidth
+	if clipped {
+		inner = r.Width - 1
+	}
+
+	border := func(left, right string) string {
+		if clipped {
+			return left + strings.Repeat("─", inner)
+		}
+		return left + strings.Repeat("─", inner) + right
+	}
+	line := func(text string) string {
+		text = runewidth.FillRight(truncateText(text, inner), inner)
+		if clipped {
+			return "│" + text
+		}
+		return "│" + text + "│"
+	}
+
+	cost := fmt.Sprintf("%d", c.gameInteractor.Player.GetCardCost(card))
+	if card.Unplayable {
+		cost = "-"
+	}
+	lines := []string{cost + " " + card.Name.String(), card.GetTypeName().String()}
+	lines = append(lines, wrapText(card.Description.String(), cardFrameWidth-2)...)
+
+	c.drawLabel(r.Row(0), AlignLeft, style, border("┌", "┐"))
+	for row := 0; row < cardFrameHeight-2; row++ {
+		text := ""
+		if row < len(lines) {
+			text = lines[row]
+		}
+		c.drawLabel(r.Row(1+row), AlignLeft, style, line(text))
+	}
+	c.drawLabel(r.Row(-1), AlignLeft, style, border("└", "┘"))
+}
@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// historyScreen はラン履歴の閲覧画面の状態を保持するのじゃ
+type historyScreen struct {
+	records []entities.RunRecord
+	err     error
+	detail  bool // 選択中のランの詳細を表示しているかどうかじゃ
+}
+
+// openHistoryScreen はラン履歴の閲覧画面を開くのじゃ
+func (c *GameController) openHistoryScreen() {
+	records, err := c.historyInteractor.ListRuns(usecase.HistoryFilter{})
+	c.historyScreen = &historyScreen{records: records, err: err}
+	c.cursorPosition = 0
+}
+
+// closeHistoryScreen はラン履歴の閲覧画面を閉じるのじゃ
+func (c *GameController) closeHistoryScreen() {
+	c.historyScreen = nil
+	c.cursorPosition = 0
+}
+
+// handleHistoryEvents はラン履歴の閲覧画面のイベントを処理するのじゃ
+func (c *GameController) handleHistoryEvents(action Action) {
+	if action == ActionHistory {
+		c.closeHistoryScreen()
+		return
+	}
+
+	if c.historyScreen.detail {
+		// 詳細表示中は決定キーで一覧に戻るのじゃ
+		if action == ActionConfirm {
+			c.historyScreen.detail = false
+		}
+		return
+	}
+
+	c.moveCursor(action, ActionUp, ActionDown)
+	if action == ActionConfirm && c.cursorPosition < len(c.historyScreen.records) {
+		c.historyScreen.detail = true
+	}
+}
+
+// ラン履歴の閲覧画面を描画する関数じゃ
+func (c *GameController) drawHistoryScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("history.title"), "")
+	c.drawLabel(area.Row(-1).Inset(1, 0), AlignLeft, DefaultStyle(), i18n.T("help.history", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionHistory, ActionCancel)))
+
+	if c.historyScreen.err != nil {
+		c.drawLabel(body.Row(0).Inset(2, 0), AlignLeft, DefaultStyle(), i18n.T("error.history_load", c.historyScreen.err))
+	} else if len(c.historyScreen.records) == 0 {
+		c.drawLabel(area.Row(area.Height/2), AlignCenter, DefaultStyle(), i18n.T("history.empty"))
+	} else if c.historyScreen.detail {
+		c.drawHistoryDetail(body.Inset(4, 0), c.historyScreen.records[c.cursorPosition])
+	} else {
+		c.drawHistoryList(body.Inset(2, 0))
+	}
+}
+
+// ランの一覧を描画する関数じゃ
+func (c *GameController) drawHistoryList(r Rect) {
+	items := make([]listItem, 0, len(c.historyScreen.records))
+	for _, record := range c.historyScreen.records {
+		items = append(items, listItem{text: i18n.T("history.row",
+			record.ID,
+			record.Timestamp.Local().Format("2006-01-02 15:04"),
+			record.GetResultName(),
+			record.Floor,
+			entities.GetCharacterName(record.Character),
+			record.Score,
+		)})
+	}
+	c.drawList(r, items, AlignLeft, regionOption)
+}
+
+// ランの詳細を描画する関数じゃ
+// 長いデッキやレリックの一覧は折り返し、入りきらない行は描かないのじゃ
+func (c *GameController) drawHistoryDetail(r Rect, record entities.RunRecord) {
+	lines := []string{
+		i18n.T("history.run", record.ID, record.Timestamp.Local().Format("2006-01-02 15:04:05")),
+		i18n.T("history.seed", record.Seed),
+		i18n.T("history.character", entities.GetCharacterName(record.Character), record.Ascension),
+		i18n.T("history.result", record.GetResultName(), record.Floor),
+	}
+	if record.KilledBy != "" {
+		lines = append(lines, i18n.T("history.killed_by", record.KilledBy))
+	}
+	lines = append(lines,
+		i18n.T("history.gold", i18n.Number(record.Gold), record.Duration.Round(time.Second)),
+		i18n.T("history.score", i18n.Number(record.Score)),
+	)
+	for _, item := range record.ScoreItems {
+		lines = append(lines, fmt.Sprintf("  %s %+d", runewidth.FillRight(item.Name, 16), item.Points))
+	}
+	lines = append(lines, "")
+	lines = append(lines, wrapText(i18n.T("history.deck", i18n.Plural("count.cards", len(record.Deck)), strings.Join(countCardNames(record.Deck), ", ")), r.Width)...)
+	if len(record.Relics) > 0 {
+		lines = append(lines, wrapText(i18n.T("history.relics", strings.Join(record.Relics, ", ")), r.Width)...)
+	} else {
+		lines = append(lines, i18n.T("history.relics", i18n.T("history.none")))
+	}
+
+	for i, line := range lines {
+		if i >= r.Height {
+			break
+		}
+		c.drawLabel(r.Row(i), AlignLeft, DefaultStyle(), line)
+	}
+}
+
+// countCardNames は同じ名前のカードをまとめて「名前 x枚数」の形にするのじゃ
+func countCardNames(names []string) []string {
+	counts := map[string]int{}
+	order := []string{}
+	for _, name := range names {
+		if counts[name] == 0 {
+			order = append(order, name)
+		}
+		counts[name]++
+	}
+
+	entries := make([]string, 0, len(order))
+	for _, name := range order {
+		if counts[name] > 1 {
+			entries = append(entries, fmt.Sprintf("%s x%d", name, counts[name]))
+		} else {
+			entries = append(entries, name)
+		}
+	}
+	return entries
+}
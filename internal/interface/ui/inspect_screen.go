@@ -0,0 +1,319 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// inspectSubject は詳しく見るものの内容じゃ
+type inspectSubject struct {
+	title    string   // 名前じゃ
+	kind     string   // 種類やコストなどの見出しじゃ
+	body     []string // ルールの文じゃ
+	numbers  []string // 補正を加えた今の数値じゃ
+	upgrade  []string // アップグレードしたときの内容じゃ
+	keywords []entities.Keyword
+}
+
+// inspectScreen は詳細の表示を開いているときの状態じゃ
+// 上下で同じ場面の他のものに切り替えられるのじゃ
+type inspectScreen struct {
+	subjects []inspectSubject
+	index    int
+}
+
+// cardSubject はカードの詳細を作るのじゃ
+// 戦闘中ならプレイヤーと敵の状態を加えた今の数値も載せるのじゃ
+func cardSubject(card entities.Card, player *entities.Player, enemy *entities.Enemy) inspectSubject {
+	subject := inspectSubject{
+		title:    card.Name.String(),
+		kind:     i18n.T("inspect.card_kind", card.GetTypeName(), card.GetRarityName(), card.EnergyCost),
+		body:     []string{card.Description.String()},
+		keywords: entities.FindKeywords(card.GetTypeName().String() + " " + card.Description.String()),
+	}
+
+	if player != nil {
+		if cost := player.GetCardCost(card); cost != card.EnergyCost {
+			subject.numbers = append(subject.numbers, i18n.T("inspect.cost", card.EnergyCost, cost))
+		}
+		if card.Damage > 0 {
+			subject.numbers = append(subject.numbers, modifiedNumber(i18n.T("inspect.damage"), card.Damage, player.AttackDamage(card.Damage, enemy)))
+		}
+		if card.Block > 0 {
+			subject.numbers = append(subject.numbers, modifiedNumber(i18n.T("inspect.block"), card.Block, player.BlockGain(card.Block)))
+		}
+	}
+
+	if upgraded, ok := card.Upgrade(); ok {
+		subject.upgrade = []string{
+			i18n.T("card.name_with_cost", upgraded.Name, upgraded.EnergyCost),
+			upgraded.Description.String(),
+		}
+	}
+	return subject
+}
+
+// cardPreview はカードを使ったときのダメージとブロックを、補正を加えた数値で1行にするのじゃ
+func cardPreview(card entities.Card, player *entities.Player, enemy *entities.Enemy) string {
+	numbers := []string{}
+	if card.Damage > 0 {
+		numbers = append(numbers, modifiedNumber(i18n.T("inspect.damage"), card.Damage, player.AttackDamage(card.Damage, enemy)))
+	}
+	if card.Block > 0 {
+		numbers = append(numbers, modifiedNumber(i18n.T("inspect.block"), card.Block, player.BlockGain(card.Block)))
+	}
+	return strings.Join(numbers, "  ")
+}
+
+// modifiedNumber は補正の前後の数値を1行にするのじゃ
+func modifiedNumber(label string, base, modified int) string {
+	if base == modified {
+		return fmt.Sprintf("%s: %d", label, base)
+	}
+	return fmt.Sprintf("%s: %d → %d", label, base, modified)
+}
+
+// enemySubject は敵の詳細を作るのじゃ
+func enemySubject(enemy *entities.Enemy) inspectSubject {
+	intention := i18n.T("inspect.intention", enemy.Intention.Name())
+	if enemy.Damage > 0 {
+		intention = i18n.T("inspect.intention_damage", enemy.Intention.Name(), enemy.Damage)
+	}
+	subject := inspectSubject{
+		title: enemy.Name.String(),
+		kind:  i18n.T("inspect.enemy"),
+		body: []string{
+			i18n.T("inspect.enemy_health", enemy.Health, enemy.MaxHealth, enemy.Block),
+			intention,
+		},
+	}
+
+	for _, status := range enemy.Statuses() {
+		subject.numbers = append(subject.numbers, statusLabel(status))
+	}
+	subject.keywords = entities.FindKeywords(strings.Join(append(subject.body, subject.numbers...), " "))
+	return subject
+}
+
+// statusSubject は戦闘中の者にかかっている状態の詳細を作るのじゃ
+func statusSubject(status entities.Status, owner i18n.Message) inspectSubject {
+	kind := i18n.T("inspect.buff")
+	switch {
+	case status.Debuff:
+		kind = i18n.T("inspect.debuff")
+	case status.Kind == entities.StatusPower:
+		kind = i18n.T("card.type.power")
+	}
+
+	subject := inspectSubject{
+		title:    status.Name.String(),
+		kind:     i18n.T("inspect.status_kind", kind, owner),
+		body:     []string{status.Description.String()},
+		keywords: entities.FindKeywords(kind + " " + status.Description.String()),
+	}
+	switch {
+	case status.IsPermanent():
+		subject.numbers = []string{i18n.T("inspect.permanent")}
+	case status.Kind == entities.StatusStacks:
+		subject.numbers = []string{i18n.T("inspect.stacks", status.Amount)}
+	default:
+		subject.numbers = []string{i18n.N("inspect.turns_left", status.Amount)}
+	}
+	return subject
+}
+
+// relicSubject はレリックの詳細を作るのじゃ
+func relicSubject(relic entities.Relic) inspectSubject {
+	return inspectSubject{
+		title:    relic.Name.String(),
+		kind:     i18n.T("inspect.relic"),
+		body:     []string{relic.Description.String()},
+		keywords: entities.FindKeywords(relic.Description.String()),
+	}
+}
+
+// potionSubject はポーションの詳細を作るのじゃ
+func potionSubject(potion entities.Potion) inspectSubject {
+	return inspectSubject{
+		title:    potion.Name.String(),
+		kind:     i18n.T("inspect.potion"),
+		body:     []string{potion.Description.String()},
+		keywords: entities.FindKeywords(potion.Description.String()),
+	}
+}
+
+// inspectSubjects は今の場面で詳しく見られるものと、最初に表示するものの番号を返すのじゃ
+// カーソルで選んでいるものがあれば、それを最初に表示するのじゃ
+func (c *GameController) inspectSubjects() ([]inspectSubject, int) {
+	if c.gameInteractor == nil {
+		return nil, 0
+	}
+	player := c.gameInteractor.Player
+	if player == nil {
+		return nil, 0
+	}
+
+	// レリックとポーションはどの場面でも見られるのじゃ
+	belongings := func() []inspectSubject {
+		subjects := []inspectSubject{}
+		for _, relic := range player.Relics {
+			subjects = append(subjects, relicSubject(relic))
+		}
+		for _, potion := range player.Potions {
+			subjects = append(subjects, potionSubject(potion))
+		}
+		return subjects
+	}
+
+	switch c.currentState() {
+	case entities.StateCombat:
+		enemy := c.gameInteractor.Enemy
+		subjects := []inspectSubject{}
+		for _, card := range player.Hand {
+			subjects = append(subjects, cardSubject(card, player, enemy))
+		}
+		// 状態は敵、プレイヤーの順に並べるのじゃ。状態の欄をクリックしたときもこの順で探すのじゃ
+		subjects = append(subjects, enemySubject(enemy))
+		for _, status := range enemy.Statuses() {
+			subjects = append(subjects, statusSubject(status, enemy.Name))
+		}
+		for _, status := range player.Statuses() {
+			subjects = append(subjects, statusSubject(status, i18n.Msg("combat.player")))
+		}
+		start := c.cursorPosition
+		if c.potionMode {
+			// ポーションは最後に並んでいるのじゃ
+			start = len(subjects) + len(player.Relics) + c.cursorPosition
+		}
+		return append(subjects, belongings()...), start
+
+	case entities.StateReward:
+		// カードを選んでいるときはそのカードを、報酬の一覧では報酬の中身を順に並べるのじゃ
+		subjects := []inspectSubject{}
+		if c.reward.choosing {
+			for _, card := range c.gameInteractor.Rewards[c.reward.index].Cards {
+				subjects = append(subjects, cardSubject(card, nil, nil))
+			}
+			return subjects, c.cursorPosition
+		}
+		start := 0
+		for index, reward := range c.gameInteractor.Rewards {
+			if index == c.cursorPosition {
+				start = len(subjects)
+			}
+			switch reward.Kind {
+			case entities.RewardPotion:
+				subjects = append(subjects, potionSubject(reward.Potion))
+			case entities.RewardRelic:
+				subjects = append(subjects, relicSubject(reward.Relic))
+			case entities.RewardCards:
+				for _, card := range reward.Cards {
+					subjects = append(subjects, cardSubject(card, nil, nil))
+				}
+			}
+		}
+		return subjects, start
+
+	case entities.StateShop:
+		subjects := []inspectSubject{}
+		for _, item := range c.gameInteractor.Shop.Items {
+			switch item.Kind {
+			case entities.ShopCard:
+				subjects = append(subjects, cardSubject(item.Card, nil, nil))
+			case entities.ShopRelic:
+				subjects = append(subjects, relicSubject(item.Relic))
+			case entities.ShopPotion:
+				subjects = append(subjects, potionSubject(item.Potion))
+			}
+		}
+		return subjects, c.cursorPosition
+
+	case entities.StateMap, entities.StateRest, entities.StateEvent:
+		return belongings(), 0
+	}
+	return nil, 0
+}
+
+// openInspectScreen は詳細の表示を開くのじゃ。見られるものが無ければ開かないのじゃ
+func (c *GameController) openInspectScreen(subjects []inspectSubject, start int) {
+	if len(subjects) == 0 {
+		return
+	}
+	c.inspectScreen = &inspectScreen{subjects: subjects, index: max(0, min(start, len(subjects)-1))}
+}
+
+// handleInspectEvents は詳細の表示のイベントを処理するのじゃ
+// 上下か左右で切り替え、詳細の操作か決定で閉じるのじゃ
+func (c *GameController) handleInspectEvents(action Action) {
+	inspect := c.inspectScreen
+	switch action {
+	case ActionInspect, ActionConfirm:
+		c.inspectScreen = nil
+	case ActionUp, ActionLeft:
+		inspect.index = (inspect.index - 1 + len(inspect.subjects)) % len(inspect.subjects)
+	case ActionDown, ActionRight:
+		inspect.index = (inspect.index + 1) % len(inspect.subjects)
+	}
+}
+
+// 詳細の表示を描画する関数じゃ
+// 元の画面の上に小窓を重ねて表示するのじゃ
+func (c *GameController) drawInspectScreen(area Rect) {
+	inspect := c.inspectScreen
+	title := i18n.T("inspect.title", inspect.index+1, len(inspect.subjects))
+	helpText := i18n.T("help.inspect", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionInspect))
+	inner := c.drawDialog(64, area.Height-6, title, helpText)
+	c.drawSubject(inner.Inset(1, 0), inspect.subjects[inspect.index])
+}
+
+// drawSubject は詳しく見るものの内容を描画するのじゃ
+// 用語は意味を添えて強調し、入りきらない行は描かないのじゃ
+func (c *GameController) drawSubject(r Rect, subject inspectSubject) {
+	type styledLine struct {
+		style Style
+		text  string
+	}
+	lines := []styledLine{
+		{SelectedStyle(), subject.title},
+		{DisabledStyle(), subject.kind},
+		{DefaultStyle(), ""},
+	}
+	for _, text := range subject.body {
+		for _, line := range wrapText(text, r.Width) {
+			lines = append(lines, styledLine{DefaultStyle(), line})
+		}
+	}
+	if len(subject.numbers) > 0 {
+		lines = append(lines, styledLine{DefaultStyle(), ""}, styledLine{DefaultStyle(), i18n.T("inspect.numbers")})
+		for _, text := range subject.numbers {
+			lines = append(lines, styledLine{StyleOf(EnergyStyleType), "  " + text})
+		}
+	}
+	if len(subject.upgrade) > 0 {
+		lines = append(lines, styledLine{DefaultStyle(), ""}, styledLine{DefaultStyle(), i18n.T("inspect.upgrade")})
+		for _, text := range subject.upgrade {
+			for _, line := range wrapText(text, r.Width-2) {
+				lines = append(lines, styledLine{StyleOf(UncommonStyleType), "  " + line})
+			}
+		}
+	}
+	if len(subject.keywords) > 0 {
+		lines = append(lines, styledLine{DefaultStyle(), ""}, styledLine{DefaultStyle(), i18n.T("inspect.keywords")})
+		for _, keyword := range subject.keywords {
+			lines = append(lines, styledLine{StyleOf(BuffStyleType), "  " + keyword.Name.String()})
+			for _, line := range wrapText(keyword.Description.String(), r.Width-4) {
+				lines = append(lines, styledLine{DefaultStyle(), "    " + line})
+			}
+		}
+	}
+
+	for i, line := range lines {
+		if i >= r.Height {
+			break
+		}
+		c.drawLabel(r.Row(i), AlignLeft, line.style, line.text)
+	}
+}
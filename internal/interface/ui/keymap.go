@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// Keymap は操作ごとに割り当てるキーの名前の一覧じゃ
+// キーの名前は文字そのものか、enter・space・esc・up などの特別なキーの名前じゃ
+type Keymap map[Action][]string
+
+// KeyBindings はキーの名前から操作を引く表じゃ
+type KeyBindings map[string]Action
+
+// 組み込みのキーマップの名前じゃ
+const (
+	KeymapVim     = "vim"
+	KeymapArrow   = "arrow"
+	KeymapHomeRow = "home_row"
+)
+
+// DefaultKeymap は何も指定しなかったときのキーマップじゃ
+const DefaultKeymap = KeymapVim
+
+// KeymapLoader は名前を指定してキーマップを読み込む関数じゃ
+// 名前が空なら既定のキーマップを元にするのじゃ
+type KeymapLoader func(preset string) (Keymap, error)
+
+// keymapPresets は組み込みのキーマップの移動と決定のキーじゃ
+// それ以外の操作のキーはどのキーマップでも共通じゃ
+var keymapPresets = map[string]Keymap{
+	KeymapVim: {
+		ActionUp:      {"k", "up"},
+		ActionDown:    {"j", "down"},
+		ActionLeft:    {"h", "left"},
+		ActionRight:   {"l", "right"},
+		ActionConfirm: {"enter", "space"},
+	},
+	KeymapArrow: {
+		ActionUp:      {"up"},
+		ActionDown:    {"down"},
+		ActionLeft:    {"left"},
+		ActionRight:   {"right"},
+		ActionConfirm: {"enter", "space"},
+	},
+	KeymapHomeRow: {
+		ActionUp:      {"i", "up"},
+		ActionDown:    {",", ".", "down"},
+		ActionLeft:    {"j", "left"},
+		ActionRight:   {"l", "right"},
+		ActionConfirm: {";", "/", "enter", "space"},
+	},
+}
+
+// commonKeys はどのキーマップでも共通の操作のキーじゃ
+var commonKeys = Keymap{
+	ActionCancel:      {"esc"},
+	ActionEndTurn:     {"e"},
+	ActionSkip:        {"s"},
+	ActionPotion:      {"p"},
+	ActionViewDeck:    {"d"},
+	ActionViewDraw:    {"f"},
+	ActionViewDiscard: {"g"},
+	ActionViewExhaust: {"x"},
+	ActionSort:        {"o"},
+	ActionHistory:     {"r"},
+	ActionUnlocks:     {"u"},
+	ActionInspect:     {"v"},
+	ActionLogUp:       {"["},
+	ActionLogDown:     {"]"},
+	ActionExportLog:   {"w"},
+	ActionCard1:       {"1"},
+	ActionCard2:       {"2"},
+	ActionCard3:       {"3"},
+	ActionCard4:       {"4"},
+	ActionCard5:       {"5"},
+	ActionCard6:       {"6"},
+	ActionCard7:       {"7"},
+	ActionCard8:       {"8"},
+	ActionCard9:       {"9"},
+	ActionCard10:      {"0"},
+}
+
+// KeymapPresetNames は組み込みのキーマップの名前を返すのじゃ
+func KeymapPresetNames() []string {
+	names := make([]string, 0, len(keymapPresets))
+	for name := range keymapPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// KeymapPreset は組み込みのキーマップを返すのじゃ
+func KeymapPreset(name string) (Keymap, bool) {
+	preset, ok := keymapPresets[name]
+	if !ok {
+		return nil, false
+	}
+
+	keymap := Keymap{}
+	for action, keys := range commonKeys {
+		keymap.Bind(action, keys)
+	}
+	for action, keys := range preset {
+		keymap.Bind(action, keys)
+	}
+	return keymap, true
+}
+
+// Bind は操作に割り当てるキーを置き換えるのじゃ
+func (k Keymap) Bind(action Action, keys []string) {
+	normalized := make([]string, 0, len(keys))
+	for _, key := range keys {
+		normalized = append(normalized, NormalizeKeyName(key))
+	}
+	k[action] = normalized
+}
+
+// Bindings はキーから操作を引く表を作るのじゃ
+// 1つのキーが複数の操作に割り当てられていたら、重なっている全てのキーを報告するのじゃ
+func (k Keymap) Bindings() (KeyBindings, error) {
+	bindings := KeyBindings{}
+	conflicts := []string{}
+	for action := ActionUp; action <= ActionCard10; action++ {
+		for _, key := range k[action] {
+			if other, ok := bindings[key]; ok && other != action {
+				conflicts = append(conflicts, i18n.T("keymap.conflict", key, other, action))
+				continue
+			}
+			bindings[key] = action
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, errors.New(i18n.T("error.keymap_conflict", strings.Join(conflicts, ", ")))
+	}
+	return bindings, nil
+}
+
+// Lookup はキーに割り当てられた操作を返すのじゃ
+// 大文字のキーに割り当てが無ければ小文字のキーの操作にするのじゃ
+func (b KeyBindings) Lookup(key string) Action {
+	if action, ok := b[key]; ok {
+		return action
+	}
+	return b[strings.ToLower(key)]
+}
+
+// NormalizeKeyName はキーの名前を揃えるのじゃ
+// 1文字のキーは大文字と小文字を区別し、特別なキーの名前は小文字にするのじゃ
+func NormalizeKeyName(key string) string {
+	if len([]rune(key)) == 1 {
+		if key == " " {
+			return "space"
+		}
+		return key
+	}
+	return strings.ToLower(key)
+}
+
+// keyLabels は特別なキーを画面に表示するときの名前じゃ
+var keyLabels = map[string]string{
+	"enter": "Enter",
+	"space": "Space",
+	"esc":   "Esc",
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+}
+
+// Label は操作に割り当てた最初のキーを画面に表示する名前で返すのじゃ
+// 複数の操作を渡すと/でつないで返すのじゃ
+func (k Keymap) Label(actions ...Action) string {
+	labels := make([]string, 0, len(actions))
+	for _, action := range actions {
+		keys := k[action]
+		if len(keys) == 0 {
+			labels = append(labels, "-")
+			continue
+		}
+		if label, ok := keyLabels[keys[0]]; ok {
+			labels = append(labels, label)
+		} else {
+			labels = append(labels, keys[0])
+		}
+	}
+	return strings.Join(labels, "/")
+}
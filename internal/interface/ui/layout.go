@@ -0,0 +1,226 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// 遊べる画面の大きさの下限じゃ
+const (
+	minScreenWidth  = 80
+	minScreenHeight = 24
+)
+
+// Rect は画面の中の長方形の範囲じゃ
+// 座標も大きさも文字のセルの単位で、全角の文字は2セルを使うのじゃ
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Align は範囲の中での文字列の寄せ方じゃ
+type Align int
+
+// 寄せ方の定義
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// Inset は上下左右を縮めた範囲を返すのじゃ
+// dxは左右それぞれ、dyは上下それぞれから縮める幅じゃ
+func (r Rect) Inset(dx, dy int) Rect {
+	return Rect{X: r.X + dx, Y: r.Y + dy, Width: max(0, r.Width-2*dx), Height: max(0, r.Height-2*dy)}
+}
+
+// Row は範囲のi行目を1行の範囲として返すのじゃ
+// 負の数なら下から数え、-1が一番下の行じゃ。範囲の外の行は高さが0になるのじゃ
+func (r Rect) Row(i int) Rect {
+	if i < 0 {
+		i += r.Height
+	}
+	if i < 0 || i >= r.Height {
+		return Rect{X: r.X, Y: r.Y + i, Width: r.Width}
+	}
+	return Rect{X: r.X, Y: r.Y + i, Width: r.Width, Height: 1}
+}
+
+// SplitTop は上からn行を切り出し、切り出した範囲と残りの範囲を返すのじゃ
+func (r Rect) SplitTop(n int) (Rect, Rect) {
+	n = max(0, min(n, r.Height))
+	return Rect{X: r.X, Y: r.Y, Width: r.Width, Height: n}, Rect{X: r.X, Y: r.Y + n, Width: r.Width, Height: r.Height - n}
+}
+
+// SplitBottom は下からn行を切り出し、切り出した範囲と残りの範囲を返すのじゃ
+func (r Rect) SplitBottom(n int) (Rect, Rect) {
+	rest, bottom := r.SplitTop(r.Height - max(0, min(n, r.Height)))
+	return bottom, rest
+}
+
+// SplitLeft は左からn桁を切り出し、切り出した範囲と残りの範囲を返すのじゃ
+func (r Rect) SplitLeft(n int) (Rect, Rect) {
+	n = max(0, min(n, r.Width))
+	return Rect{X: r.X, Y: r.Y, Width: n, Height: r.Height}, Rect{X: r.X + n, Y: r.Y, Width: r.Width - n, Height: r.Height}
+}
+
+// SplitRight は右からn桁を切り出し、切り出した範囲と残りの範囲を返すのじゃ
+func (r Rect) SplitRight(n int) (Rect, Rect) {
+	rest, right := r.SplitLeft(r.Width - max(0, min(n, r.Width)))
+	return right, rest
+}
+
+// Rows は範囲を上から順に指定の高さの行に分けるのじゃ
+// 高さに0を指定した行は、残りの高さを均等に分けるのじゃ
+func (r Rect) Rows(heights ...int) []Rect {
+	sizes := fillSizes(r.Height, heights)
+	rows := make([]Rect, len(sizes))
+	y := r.Y
+	for i, size := range sizes {
+		rows[i] = Rect{X: r.X, Y: y, Width: r.Width, Height: size}
+		y += size
+	}
+	return rows
+}
+
+// Columns は範囲を左から順に指定の幅の列に分けるのじゃ
+// 幅に0を指定した列は、残りの幅を均等に分けるのじゃ
+func (r Rect) Columns(widths ...int) []Rect {
+	sizes := fillSizes(r.Width, widths)
+	columns := make([]Rect, len(sizes))
+	x := r.X
+	for i, size := range sizes {
+		columns[i] = Rect{X: x, Y: r.Y, Width: size, Height: r.Height}
+		x += size
+	}
+	return columns
+}
+
+// fillSizes は指定の大きさを全体に収め、0の分に残りを均等に割り振るのじゃ
+func fillSizes(total int, sizes []int) []int {
+	result := make([]int, len(sizes))
+	rest, fills := total, 0
+	for i, size := range sizes {
+		if size <= 0 {
+			fills++
+			continue
+		}
+		result[i] = max(0, min(size, rest))
+		rest -= result[i]
+	}
+	for i, size := range sizes {
+		if size <= 0 {
+			result[i] = rest / fills
+			rest -= result[i]
+			fills--
+		}
+	}
+	return result
+}
+
+// Center は範囲の中央に指定の大きさの範囲を置くのじゃ
+// 範囲からはみ出す大きさは範囲に合わせて縮めるのじゃ
+func (r Rect) Center(width, height int) Rect {
+	width, height = max(0, min(width, r.Width)), max(0, min(height, r.Height))
+	return Rect{X: r.X + (r.Width-width)/2, Y: r.Y + (r.Height-height)/2, Width: width, Height: height}
+}
+
+// CenterColumn は範囲の高さはそのままに、中央に指定の幅の列を置くのじゃ
+func (r Rect) CenterColumn(width int) Rect {
+	return r.Center(width, r.Height)
+}
+
+// Contains は座標が範囲の中にあるかを判定するのじゃ
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// textWidth は文字列を表示したときの幅を返すのじゃ
+func textWidth(text string) int {
+	return runewidth.StringWidth(text)
+}
+
+// truncateText は表示幅に収まるようにテキストを切り詰めるのじゃ
+func truncateText(text string, width int) string {
+	return runewidth.Truncate(text, max(0, width), "…")
+}
+
+// alignText は文字列を幅に収めて寄せたときの、左端からのずれと切り詰めた文字列を返すのじゃ
+func alignText(text string, width int, align Align) (int, string) {
+	text = truncateText(text, width)
+	switch align {
+	case AlignCenter:
+		return (width - textWidth(text)) / 2, text
+	case AlignRight:
+		return width - textWidth(text), text
+	default:
+		return 0, text
+	}
+}
+
+// wrapText は表示幅で折り返した行に分けるのじゃ
+// 英語は単語の切れ目で折り返し、日本語はどの文字の間でも折り返すのじゃ
+// 改行があればそこでも行を分け、幅より長い単語は文字の単位で折るのじゃ
+func wrapText(text string, width int) []string {
+	lines := []string{}
+	if width <= 0 {
+		return lines
+	}
+	for _, paragraph := range strings.Split(text, "\n") {
+		line, lineWidth := "", 0
+		for _, word := range splitWords(paragraph) {
+			blank := strings.TrimSpace(word) == ""
+			if lineWidth > 0 && lineWidth+textWidth(word) > width {
+				lines = append(lines, strings.TrimRight(line, " "))
+				line, lineWidth = "", 0
+			}
+			// 行の頭の空白は捨てるのじゃ
+			if blank && lineWidth == 0 {
+				continue
+			}
+			for textWidth(word) > width {
+				head := runewidth.Truncate(word, width, "")
+				if head == "" {
+					head = string([]rune(word)[:1])
+				}
+				lines = append(lines, head)
+				word = word[len(head):]
+			}
+			line += word
+			lineWidth += textWidth(word)
+		}
+		if line = strings.TrimRight(line, " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// splitWords は文字列を折り返せる単位に分けるのじゃ
+// 空白の並び、空白を含まない半角の文字の並び、全角の文字1つずつが1つの単位じゃ
+func splitWords(text string) []string {
+	words := []string{}
+	word := []rune{}
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+	for _, r := range text {
+		switch {
+		case runewidth.RuneWidth(r) == 2:
+			flush()
+			words = append(words, string(r))
+		case len(word) > 0 && unicode.IsSpace(word[0]) != unicode.IsSpace(r):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+	return words
+}
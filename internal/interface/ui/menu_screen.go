@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// menuItem はメニューの項目を表す型じゃ
+type menuItem int
+
+// メニューの項目の定義
+const (
+	menuContinue menuItem = iota
+	menuNewRun
+	menuSetup
+	menuCompendium
+	menuHistory
+	menuSettings
+	menuQuit
+)
+
+// メニューの項目の表示名のキーじゃ
+var menuItemNames = map[menuItem]string{
+	menuContinue:   "menu.continue",
+	menuNewRun:     "menu.new_run",
+	menuSetup:      "menu.setup",
+	menuCompendium: "menu.compendium",
+	menuHistory:    "menu.history",
+	menuSettings:   "menu.settings",
+	menuQuit:       "menu.quit",
+}
+
+// menuState はメニューの状態を保持するのじゃ
+type menuState struct {
+	hasSavedRun bool  // 再開できるランがあるかどうかじゃ
+	err         error // 直前の操作で起きたエラーじゃ
+	// メニューから開いている画面の状態じゃ
+	setup      *setupScreen
+	compendium *compendiumScreen
+	settings   bool
+}
+
+// refreshMenu はメニューに戻ったときに中断したランの有無を確かめ直すのじゃ
+func (c *GameController) refreshMenu() {
+	c.menu.hasSavedRun = c.menuInteractor.HasSavedRun()
+}
+
+// menuItems は今選べるメニューの項目を返すのじゃ
+// 中断したランがあるときだけ続きからを選べるのじゃ
+// 行単位で遊ぶときは、ランの設定はコマンドラインで指定するので、ランを始めるか終えるかだけを選べるのじゃ
+func (c *GameController) menuItems() []menuItem {
+	items := []menuItem{}
+	if c.menu.hasSavedRun {
+		items = append(items, menuContinue)
+	}
+	if c.narrating() {
+		return append(items, menuNewRun, menuQuit)
+	}
+	return append(items, menuNewRun, menuSetup, menuCompendium, menuHistory, menuSettings, menuQuit)
+}
+
+// closeMenuScreen はメニューから開いている画面を閉じるのじゃ
+// 閉じる画面が無ければfalseを返すのじゃ
+func (c *GameController) closeMenuScreen() bool {
+	// 図鑑の詳細の表示はカーソルを動かさずに閉じるのじゃ
+	if c.inspectScreen != nil {
+		c.inspectScreen = nil
+		return true
+	}
+
+	switch {
+	case c.historyScreen != nil:
+		c.closeHistoryScreen()
+	case c.menu.setup != nil:
+		c.menu.setup = nil
+	case c.menu.compendium != nil:
+		c.menu.compendium = nil
+	case c.menu.settings:
+		c.menu.settings = false
+	default:
+		return false
+	}
+	c.cursorPosition = 0
+	return true
+}
+
+// handleMenuEvents はメニューのイベントを処理するのじゃ
+func (c *GameController) handleMenuEvents(action Action) {
+	switch {
+	case c.menu.setup != nil:
+		c.handleSetupEvents(action)
+		return
+	case c.menu.compendium != nil:
+		c.handleCompendiumEvents(action)
+		return
+	case c.menu.settings:
+		c.handleSettingsEvents(action)
+		return
+	}
+
+	if action != ActionConfirm {
+		return
+	}
+	items := c.menuItems()
+	if c.cursorPosition < 0 || c.cursorPosition >= len(items) {
+		return
+	}
+
+	c.menu.err = nil
+	switch items[c.cursorPosition] {
+	case menuContinue:
+		c.startMenuRun(c.menuInteractor.ContinueRun())
+	case menuNewRun:
+		c.startMenuRun(c.menuInteractor.NewRun())
+	case menuSetup:
+		c.openSetupScreen()
+	case menuCompendium:
+		c.openCompendiumScreen()
+	case menuHistory:
+		c.openHistoryScreen()
+	case menuSettings:
+		c.menu.settings = true
+		c.cursorPosition = 0
+	case menuQuit:
+		c.quit = true
+	}
+}
+
+// startMenuRun はメニューで始めたランに移るのじゃ
+func (c *GameController) startMenuRun(run *usecase.GameInteractor, err error) {
+	if err != nil {
+		c.menu.err = err
+		c.refreshMenu()
+		return
+	}
+	c.StartRun(run)
+}
+
+// メニュー画面を描画する関数じゃ
+func (c *GameController) drawMenuScreen(area Rect) {
+	switch {
+	case c.menu.setup != nil:
+		c.drawSetupScreen(area)
+		return
+	case c.menu.compendium != nil:
+		c.drawCompendiumScreen(area)
+		return
+	case c.menu.settings:
+		c.drawSettingsScreen(area)
+		return
+	}
+
+	_, body := area.SplitTop(area.Height / 4)
+	c.drawLabel(body.Row(0), AlignCenter, DefaultStyle(), "Slay the CLI")
+
+	// 新しいランの設定を表示するのじゃ
+	character := i18n.T("setup.choose_later")
+	if selected, ok := entities.GetCharacterByID(c.menuInteractor.Character); ok {
+		character = selected.Name.String()
+	}
+	c.drawLabel(body.Row(2), AlignCenter, DefaultStyle(), i18n.T("menu.setup_summary", character, c.menuInteractor.Ascension))
+
+	// メニューの項目を並べるのじゃ
+	items := []listItem{}
+	for _, item := range c.menuItems() {
+		items = append(items, listItem{text: i18n.T(menuItemNames[item])})
+	}
+	_, list := body.SplitTop(4)
+	list, _ = list.SplitTop(len(items))
+	c.drawList(list, items, AlignCenter, regionOption)
+
+	if c.menu.err != nil {
+		c.drawNotice(area, i18n.T("error.start_run", c.menu.err))
+	}
+
+	// 操作説明
+	c.drawLabel(area.Row(-3), AlignCenter, DefaultStyle(), i18n.T("help.menu", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionCancel)))
+}
@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// regionKind はクリックできる場所の種類じゃ
+type regionKind int
+
+// クリックできる場所の種類の定義
+const (
+	regionOption   regionKind = iota // 一覧の選択肢じゃ。クリックで決定するのじゃ
+	regionHandCard                   // 手札のカードじゃ
+	regionPotion                     // 戦闘中のポーションじゃ
+	regionEnemy                      // 戦っている敵じゃ
+	regionEndTurn                    // ターン終了のボタンじゃ
+	regionStatus                     // 状態の欄の1つじゃ。番号は敵、プレイヤーの順に数えるのじゃ
+)
+
+// hitRegion は描画したものがクリックされたかを調べるための範囲じゃ
+type hitRegion struct {
+	Rect
+	kind  regionKind
+	index int // 選択肢やカードの番号じゃ
+}
+
+// addRegion は描画したものをクリックできる場所として登録するのじゃ
+// 描画のたびに登録し直すので、画面に見えているものだけが登録されているのじゃ
+func (c *GameController) addRegion(r Rect, kind regionKind, index int) {
+	c.regions = append(c.regions, hitRegion{Rect: r, kind: kind, index: index})
+}
+
+// regionAt は座標にある場所を返すのじゃ
+// 後から描いたものが上に見えているので、後に登録したものを優先するのじゃ
+func (c *GameController) regionAt(x, y int) (hitRegion, bool) {
+	for i := len(c.regions) - 1; i >= 0; i-- {
+		if c.regions[i].Contains(x, y) {
+			return c.regions[i], true
+		}
+	}
+	return hitRegion{}, false
+}
+
+// handleMouseEvents はマウスの操作を処理するのじゃ
+// マウスを重ねると選び、クリックで決定するのじゃ
+// 手札のカードはクリックで掴み、敵かもう一度そのカードをクリックすると使うのじゃ
+func (c *GameController) handleMouseEvents(event EventPort) {
+	// 敵のターンを見せている間は、クリックで最後まで飛ばすのじゃ
+	if c.currentState() == entities.StateCombat && c.animator.busy() && c.pauseScreen == nil {
+		if event.IsClick() {
+			c.animator.finish()
+		}
+		return
+	}
+
+	x, y := event.MousePosition()
+	region, ok := c.regionAt(x, y)
+	if !ok {
+		// 何もないところをクリックしたら掴んだカードを離すのじゃ
+		if event.IsClick() {
+			c.targeting = false
+		}
+		return
+	}
+
+	switch region.kind {
+	case regionOption:
+		c.cursorPosition = region.index
+		if event.IsClick() {
+			c.confirmSelection()
+		}
+
+	case regionHandCard:
+		if event.IsClick() {
+			if c.targeting && !c.potionMode && c.cursorPosition == region.index {
+				c.confirmSelection()
+				return
+			}
+			c.potionMode = false
+			c.cursorPosition = region.index
+			c.targeting = true
+			return
+		}
+		// カードを掴んでいる間は、敵まで動かす途中で他のカードに重ねても選び直さないのじゃ
+		if !c.targeting {
+			c.potionMode = false
+			c.cursorPosition = region.index
+		}
+
+	case regionPotion:
+		if event.IsClick() {
+			c.targeting = false
+			c.potionMode = true
+			c.cursorPosition = region.index
+			c.confirmSelection()
+		}
+
+	case regionEnemy:
+		if event.IsClick() && c.targeting {
+			c.confirmSelection()
+		}
+
+	case regionEndTurn:
+		if event.IsClick() {
+			c.requestEndTurn()
+		}
+
+	case regionStatus:
+		// 詳細の一覧では手札と敵の後に状態が並んでいるのじゃ
+		if event.IsClick() {
+			subjects, _ := c.inspectSubjects()
+			c.openInspectScreen(subjects, len(c.gameInteractor.Player.Hand)+1+region.index)
+		}
+	}
+}
+
+// confirmSelection はカーソルで選んでいるものに決めるのじゃ
+// 決定のキーとクリックのどちらからも使うのじゃ
+func (c *GameController) confirmSelection() {
+	// ポーズメニューを開いているときはそちらの項目を選ぶのじゃ
+	if c.pauseScreen != nil {
+		if !c.pauseScreen.settings {
+			c.selectPauseItem(c.cursorPosition)
+		}
+		return
+	}
+
+	switch c.currentState() {
+	case 1: // StateMap
+		// マップ選択画面ではカーソルでノードを選択するのじゃ
+		if c.cursorPosition >= 0 && c.cursorPosition < len(c.gameInteractor.GameMap.CurrentNode.Connections) {
+			c.gameInteractor.SelectMapNode(c.gameInteractor.GameMap.CurrentNode.Connections[c.cursorPosition])
+			c.cursorPosition = 0 // カーソルをリセット
+		}
+
+	case 2: // StateCombat
+		c.targeting = false
+		// ポーション選択中はポーションを使うのじゃ
+		if c.potionMode {
+			c.gameInteractor.UsePotion(c.cursorPosition)
+			c.potionMode = false
+			c.cursorPosition = 0
+			return
+		}
+
+		if c.cursorPosition >= 0 && c.cursorPosition < len(c.gameInteractor.Player.Hand) {
+			c.gameInteractor.UseCard(c.cursorPosition)
+		}
+		// 敵を倒して報酬に移ったら、報酬の一覧の先頭から選ぶのじゃ
+		if c.currentState() != entities.StateCombat {
+			c.cursorPosition = 0
+			return
+		}
+		// 使ったカードの分だけ手札が減るので、カーソルを手札の中に収めるのじゃ
+		c.cursorPosition = max(0, min(c.cursorPosition, len(c.gameInteractor.Player.Hand)-1))
+
+	case 3: // StateReward
+		// カーソルで選んだ報酬を受け取るのじゃ
+		c.selectRewardOption(c.cursorPosition)
+
+	case 4: // StateRest
+		// カーソル位置で選択肢を決定
+		if c.cursorPosition == 0 {
+			c.gameInteractor.RestHeal()
+		} else if c.cursorPosition == 1 {
+			c.gameInteractor.RestUpgrade()
+		}
+		c.cursorPosition = 0 // カーソルをリセット
+
+	case 5: // StateShop
+		// カーソルで商品を選択して購入するのじゃ
+		c.gameInteractor.BuyShopItem(c.cursorPosition)
+
+	case 8: // StateCharacterSelect
+		// カーソルでキャラクターを選んでランを始めるのじゃ
+		characters := entities.AllCharacters()
+		if c.cursorPosition >= 0 && c.cursorPosition < len(characters) {
+			if c.gameInteractor.SelectCharacter(characters[c.cursorPosition].ID) {
+				c.cursorPosition = 0 // カーソルをリセット
+			}
+		}
+	}
+}
@@ -0,0 +1,469 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// narration は行単位で遊ぶときに、どこまで様子を伝えたかを保持するのじゃ
+type narration struct {
+	// 伝え終えた戦闘ログとその行数じゃ。ランが替わったら最初から数え直すのじゃ
+	log     *entities.CombatLog
+	entries int
+}
+
+// narrating は行単位で遊ぶスクリーンを使っているかを判定するのじゃ
+func (c *GameController) narrating() bool {
+	_, ok := c.screen.(NarratorPort)
+	return ok
+}
+
+// handleCommand は行単位で打ち込まれた命令を処理し、結果を伝えるのじゃ
+// 命令が使えなかったときは理由だけを伝え、様子は変えないのじゃ
+func (c *GameController) handleCommand(command Command) {
+	narrator, ok := c.screen.(NarratorPort)
+	if !ok {
+		return
+	}
+
+	switch command.Kind {
+	case CommandLook:
+		narrator.Narrate(c.describe())
+		return
+	case CommandHelp:
+		narrator.Narrate(commandHelp())
+		return
+	case CommandPile:
+		lines, err := c.describePile(command.pile)
+		if err != nil {
+			lines = []string{err.Error()}
+		}
+		narrator.Narrate(lines)
+		return
+	}
+
+	// ターン終了を確かめているときに他の命令を打てば、ターンは終えないのじゃ
+	if command.Kind != CommandEnd {
+		c.endTurnPrompt = false
+	}
+	if err := c.runCommand(command); err != nil {
+		narrator.Narrate([]string{err.Error()})
+		return
+	}
+
+	// ランが終わったらメニューに戻るのじゃ
+	if c.gameInteractor != nil && c.gameInteractor.IsDone() {
+		c.leaveRun()
+	}
+	if !c.quit {
+		narrator.Narrate(c.describe())
+	}
+}
+
+// runCommand は様子を変える命令を実行するのじゃ
+func (c *GameController) runCommand(command Command) error {
+	notHere := errors.New(i18n.T("line.not_here"))
+	state := c.currentState()
+
+	// ポーズメニューでは選ぶか戻ることしかできないのじゃ
+	if c.pauseScreen != nil && command.Kind != CommandPick && command.Kind != CommandQuit {
+		return notHere
+	}
+
+	switch command.Kind {
+	case CommandQuit:
+		c.cancel()
+
+	case CommandPlay:
+		if state != entities.StateCombat {
+			return notHere
+		}
+		hand := c.gameInteractor.Player.Hand
+		if command.Index >= len(hand) {
+			return errors.New(i18n.T("line.no_card", command.Index+1))
+		}
+		if err := c.checkTarget(command.Target); err != nil {
+			return err
+		}
+		if !c.gameInteractor.UseCard(command.Index) {
+			return errors.New(i18n.T("line.cannot_play", hand[command.Index].Name))
+		}
+
+	case CommandPotion:
+		if state != entities.StateCombat {
+			return notHere
+		}
+		if command.Index >= len(c.gameInteractor.Player.Potions) {
+			return errors.New(i18n.T("line.no_potion", command.Index+1))
+		}
+		if err := c.checkTarget(command.Target); err != nil {
+			return err
+		}
+		c.gameInteractor.UsePotion(command.Index)
+
+	case CommandEnd:
+		if state != entities.StateCombat {
+			return notHere
+		}
+		// まだ使えるカードがあれば、もう一度打ち込んでもらって確かめるのじゃ
+		if !c.endTurnPrompt && c.needsEndTurnConfirm() {
+			c.endTurnPrompt = true
+			return errors.New(i18n.T("line.confirm_end_turn", c.gameInteractor.Player.Energy))
+		}
+		// 敵のターンは間を置かずに最後まで進めるのじゃ
+		c.endTurn()
+		c.animator.finish()
+
+	case CommandMap:
+		if state != entities.StateMap {
+			return notHere
+		}
+		return c.pick(command.Index)
+
+	case CommandPick:
+		// イベントとゲームオーバーでは何を選んでも先に進むのじゃ
+		if c.pauseScreen == nil && (state == entities.StateEvent || state == entities.StateGameOver) {
+			return c.runCommand(Command{Kind: CommandSkip})
+		}
+		return c.pick(command.Index)
+
+	case CommandSkip:
+		switch state {
+		case entities.StateReward:
+			// カードを選んでいればそのカード報酬を捨て、一覧では先に進むのじゃ
+			// 先に進むのを確かめているときは、もう一度打てば報酬を残して進むのじゃ
+			switch {
+			case c.reward.choosing:
+				c.skipRewardOption(c.reward.index)
+			case c.reward.confirmLeave:
+				return c.selectRewardOption(1)
+			default:
+				return c.selectRewardOption(len(c.gameInteractor.Rewards))
+			}
+			return nil
+		case entities.StateShop, entities.StateEvent:
+			c.gameInteractor.ReturnToMap()
+		case entities.StateGameOver:
+			c.gameInteractor.SetDone(true)
+		default:
+			return notHere
+		}
+	}
+	c.cursorPosition = 0
+	return nil
+}
+
+// checkTarget はカードやポーションを使う相手がいるかを確かめるのじゃ
+// 敵は1体しかいないので、1番だけを選べるのじゃ
+func (c *GameController) checkTarget(target int) error {
+	if target != 0 {
+		return errors.New(i18n.T("line.no_enemy", target+1))
+	}
+	return nil
+}
+
+// pick は今の場面の選択肢を番号で選ぶのじゃ
+// キーで決めたときと同じ処理を通し、選べなかったときは理由を返すのじゃ
+func (c *GameController) pick(index int) error {
+	choices := c.choices()
+	if len(choices) == 0 {
+		return errors.New(i18n.T("line.not_here"))
+	}
+	if index >= len(choices) {
+		return errors.New(i18n.T("line.no_choice", index+1))
+	}
+
+	c.cursorPosition = index
+	if c.pauseScreen != nil {
+		c.selectPauseItem(index)
+		return nil
+	}
+	switch c.currentState() {
+	case 0: // StateMenu
+		c.handleMenuEvents(ActionConfirm)
+	case 3: // StateReward
+		return c.selectRewardOption(index)
+	case 5: // StateShop
+		if !c.gameInteractor.BuyShopItem(index) {
+			return errors.New(i18n.T("line.cannot_buy", c.gameInteractor.Shop.Items[index].GetName()))
+		}
+	case 8: // StateCharacterSelect
+		character := entities.AllCharacters()[index]
+		if !c.gameInteractor.SelectCharacter(character.ID) {
+			return errors.New(i18n.T("line.locked", character.Name))
+		}
+	default:
+		c.confirmSelection()
+	}
+	return nil
+}
+
+// choices は今の場面で番号で選べる選択肢を返すのじゃ
+func (c *GameController) choices() []string {
+	if c.pauseScreen != nil {
+		return c.pauseChoices()
+	}
+
+	choices := []string{}
+	switch c.currentState() {
+	case 0: // StateMenu
+		for _, item := range c.menuItems() {
+			choices = append(choices, i18n.T(menuItemNames[item]))
+		}
+
+	case 1: // StateMap
+		for _, node := range c.gameInteractor.GameMap.CurrentNode.Connections {
+			choices = append(choices, i18n.T("map.node", node.GetNodeTypeName(), node.Position.Floor))
+		}
+
+	case 3: // StateReward
+		choices = c.rewardChoices()
+
+	case 4: // StateRest
+		choices = append(choices, i18n.T("rest.heal", c.gameInteractor.RestHealPercent()), i18n.T("rest.upgrade"))
+
+	case 5: // StateShop
+		for _, item := range c.gameInteractor.Shop.Items {
+			price := i18n.T("shop.price", item.Price)
+			if item.Sold {
+				price = i18n.T("shop.sold_out")
+			}
+			choices = append(choices, fmt.Sprintf("%s - %s: %s", strings.TrimSpace(price), item.GetName(), item.GetDescription()))
+		}
+
+	case 8: // StateCharacterSelect
+		for _, character := range entities.AllCharacters() {
+			name := i18n.T("character_select.row", character.Name, character.MaxHealth, i18n.Number(character.Gold))
+			if !c.gameInteractor.IsCharacterUnlocked(character.ID) {
+				name = i18n.T("character_select.locked", character.Name)
+			}
+			choices = append(choices, name+" - "+character.Description.String())
+		}
+	}
+	return choices
+}
+
+// describe は今の様子を読み上げやすい短い行にまとめるのじゃ
+// 前に伝えてから戦闘で起きた出来事を先に並べ、その後に場面の様子と選択肢を並べるのじゃ
+func (c *GameController) describe() []string {
+	lines := c.newLogEntries()
+
+	// ポーズメニューを開いているときは、その選択肢だけを伝えるのじゃ
+	if c.pauseScreen != nil {
+		lines = append(lines, c.pauseTitle())
+		if c.pauseScreen.confirmAbandon {
+			lines = append(lines, i18n.T("pause.abandon_warning"))
+		}
+		lines = append(lines, numbered(c.choices())...)
+		return append(lines, i18n.T("line.hint.pick"))
+	}
+
+	switch c.currentState() {
+	case 0: // StateMenu
+		character := i18n.T("setup.choose_later")
+		if selected, ok := entities.GetCharacterByID(c.menuInteractor.Character); ok {
+			character = selected.Name.String()
+		}
+		lines = append(lines, i18n.T("line.menu"), i18n.T("menu.setup_summary", character, c.menuInteractor.Ascension))
+		if c.menu.err != nil {
+			lines = append(lines, i18n.T("error.start_run", c.menu.err))
+		}
+		lines = append(lines, numbered(c.choices())...)
+		lines = append(lines, i18n.T("line.hint.pick"))
+
+	case 1: // StateMap
+		player := c.gameInteractor.Player
+		lines = append(lines,
+			i18n.T("map.floor", c.gameInteractor.GameMap.CurrentNode.Position.Floor),
+			i18n.T("map.player", player.Health, player.MaxHealth, i18n.Number(player.Gold)),
+			i18n.T("map.choices"),
+		)
+		lines = append(lines, numbered(c.choices())...)
+		lines = append(lines, i18n.T("line.hint.map"))
+
+	case 2: // StateCombat
+		lines = append(lines, c.describeCombat()...)
+
+	case 3: // StateReward
+		player := c.gameInteractor.Player
+		lines = append(lines, c.rewardTitle())
+		if c.reward.confirmLeave {
+			lines = append(lines, i18n.N("reward.leave_warning", len(c.gameInteractor.Rewards)))
+		} else {
+			lines = append(lines, i18n.T("map.player", player.Health, player.MaxHealth, i18n.Number(player.Gold)))
+		}
+		lines = append(lines, numbered(c.choices())...)
+		switch {
+		case c.reward.choosing:
+			lines = append(lines, i18n.T("line.hint.reward_cards"))
+		case c.reward.confirmLeave:
+			lines = append(lines, i18n.T("line.hint.pick"))
+		default:
+			lines = append(lines, i18n.T("line.hint.reward"))
+		}
+
+	case 4: // StateRest
+		lines = append(lines, i18n.T("rest.title"), i18n.T("rest.player", c.gameInteractor.Player.Health, c.gameInteractor.Player.MaxHealth))
+		lines = append(lines, numbered(c.choices())...)
+		lines = append(lines, i18n.T("line.hint.pick"))
+
+	case 5: // StateShop
+		lines = append(lines, i18n.T("shop.title"), i18n.T("shop.gold", i18n.Number(c.gameInteractor.Player.Gold)))
+		lines = append(lines, numbered(c.choices())...)
+		lines = append(lines, i18n.T("line.hint.shop"))
+
+	case 6: // StateEvent
+		lines = append(lines, i18n.T("event.title"), i18n.T("event.not_implemented"), i18n.T("line.hint.continue"))
+
+	case 7: // StateGameOver
+		lines = append(lines, c.describeGameOver()...)
+
+	case 8: // StateCharacterSelect
+		lines = append(lines, i18n.T("character_select.title"))
+		lines = append(lines, numbered(c.choices())...)
+		lines = append(lines, i18n.T("line.hint.pick"))
+	}
+	return lines
+}
+
+// newLogEntries は前に伝えてから戦闘ログに増えた出来事を返すのじゃ
+func (c *GameController) newLogEntries() []string {
+	var log *entities.CombatLog
+	if c.gameInteractor != nil {
+		log = c.gameInteractor.CombatLog
+	}
+	if log != c.narration.log {
+		c.narration = narration{log: log}
+	}
+
+	lines := []string{}
+	if log == nil {
+		return lines
+	}
+	for _, entry := range log.Entries[min(c.narration.entries, log.Len()):] {
+		if !c.settings.LogVerbosity.Shows(entry.Kind) {
+			continue
+		}
+		lines = append(lines, entry.Text.String())
+	}
+	c.narration.entries = log.Len()
+	return lines
+}
+
+// describeCombat は戦闘の様子をまとめるのじゃ
+// 敵、プレイヤー、手札、ポーション、カードの束の順に並べるのじゃ
+func (c *GameController) describeCombat() []string {
+	player := c.gameInteractor.Player
+	enemy := c.gameInteractor.Enemy
+
+	lines := []string{
+		i18n.T("line.enemy", 1, enemy.Name, enemy.Health, enemy.MaxHealth, enemy.Block, i18n.T("combat.intent", enemy.Intention.Name(), enemy.Damage)),
+	}
+	if statuses := enemy.Statuses(); len(statuses) > 0 {
+		lines = append(lines, i18n.T("line.statuses", describeStatuses(statuses)))
+	}
+	lines = append(lines, i18n.T("line.player", player.Health, player.MaxHealth, player.Block, player.Energy, player.MaxEnergy))
+	if statuses := player.Statuses(); len(statuses) > 0 {
+		lines = append(lines, i18n.T("line.statuses", describeStatuses(statuses)))
+	}
+
+	lines = append(lines, i18n.T("line.hand"))
+	for i, card := range player.Hand {
+		cost := fmt.Sprintf("%d", player.GetCardCost(card))
+		if card.Unplayable {
+			cost = "-"
+		}
+		text := i18n.T("line.card", i+1, card.Name, cost, card.Description)
+		if card.Unplayable || player.GetCardCost(card) > player.Energy {
+			text += " " + i18n.T("line.unplayable")
+		}
+		lines = append(lines, text)
+	}
+
+	if len(player.Potions) > 0 {
+		lines = append(lines, i18n.T("combat.potions", len(player.Potions), player.PotionSlots))
+		for i, potion := range player.Potions {
+			lines = append(lines, fmt.Sprintf("%d. %s: %s", i+1, potion.Name, potion.Description))
+		}
+	}
+
+	lines = append(lines,
+		i18n.T("line.piles", i18n.Plural("count.cards", len(player.DrawPile)), i18n.Plural("count.cards", len(player.DiscardPile)), i18n.Plural("count.cards", len(player.ExhaustPile))),
+		i18n.T("line.hint.combat"),
+	)
+	return lines
+}
+
+// describeStatuses は状態を状態の欄と同じ短い表記で並べるのじゃ
+func describeStatuses(statuses []entities.Status) string {
+	labels := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		labels = append(labels, statusLabel(status))
+	}
+	return strings.Join(labels, ", ")
+}
+
+// describeGameOver はランの結果とスコアの内訳をまとめるのじゃ
+func (c *GameController) describeGameOver() []string {
+	lines := []string{i18n.T("gameover.title")}
+	if c.gameInteractor.Victory {
+		lines[0] = i18n.T("gameover.victory")
+	}
+	if c.gameInteractor.AscensionUnlocked {
+		level := c.gameInteractor.Ascension.Level + 1
+		lines = append(lines, i18n.T("gameover.ascension_unlocked", level, entities.GetAscensionDescription(level)))
+	}
+
+	lines = append(lines, i18n.T("gameover.score"))
+	for _, item := range c.gameInteractor.Score.Items {
+		lines = append(lines, fmt.Sprintf("%s %s %+d", item.Name, item.Detail, item.Points))
+	}
+	lines = append(lines, fmt.Sprintf("%s %s", i18n.T("gameover.total"), i18n.Number(c.gameInteractor.Score.Total)))
+	for _, tier := range c.gameInteractor.NewUnlocks {
+		lines = append(lines, i18n.T("gameover.new_unlocks", describeUnlockTier(tier)))
+	}
+
+	// 履歴やプロフィールの保存に失敗していたら知らせるのじゃ
+	if err := c.gameInteractor.LeaderboardError; err != nil {
+		lines = append(lines, i18n.T("error.leaderboard_save", err))
+	}
+	if err := c.gameInteractor.HistoryError; err != nil {
+		lines = append(lines, i18n.T("error.history_save", err))
+	}
+	if err := c.gameInteractor.ProfileError; err != nil {
+		lines = append(lines, i18n.T("error.profile_save", err))
+	}
+	return append(lines, i18n.T("line.hint.continue"))
+}
+
+// describePile はカードの束の中身を並べるのじゃ
+// 画面の一覧と同じく、山札の順番が分からないように並べ替えてから伝えるのじゃ
+func (c *GameController) describePile(kind pileKind) ([]string, error) {
+	if c.gameInteractor == nil || (kind != pileDeck && c.currentState() != entities.StateCombat) {
+		return nil, errors.New(i18n.T("line.not_here"))
+	}
+
+	cards := c.sortedPile(kind, sortByCost)
+	title := i18n.T("pile.title", i18n.T(pileNames[kind]), i18n.Plural("count.cards", len(cards)), i18n.T(cardSortKeyNames[sortByCost]))
+	lines := []string{strings.TrimSpace(title)}
+	if len(cards) == 0 {
+		return append(lines, i18n.T("pile.empty")), nil
+	}
+	for _, card := range cards {
+		lines = append(lines, i18n.T("line.pile_card", card.Name, c.pileCardCost(kind, card), card.Description))
+	}
+	return lines, nil
+}
+
+// numbered は選択肢に1から始まる番号を付けるのじゃ
+func numbered(choices []string) []string {
+	lines := make([]string, 0, len(choices))
+	for i, choice := range choices {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, choice))
+	}
+	return lines
+}
@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// pauseItem はポーズメニューの項目を表す型じゃ
+type pauseItem int
+
+// ポーズメニューの項目の定義
+const (
+	pauseResume pauseItem = iota
+	pauseSettings
+	pauseSaveQuit
+	pauseAbandon
+)
+
+// ポーズメニューの項目の表示名のキーじゃ
+var pauseItemNames = map[pauseItem]string{
+	pauseResume:   "pause.resume",
+	pauseSettings: "pause.settings",
+	pauseSaveQuit: "pause.save_quit",
+	pauseAbandon:  "pause.abandon",
+}
+
+// pauseScreen はランの途中で開くポーズメニューの状態じゃ
+type pauseScreen struct {
+	returnCursor   int  // 閉じたときに戻す元の画面のカーソル位置じゃ
+	settings       bool // 設定画面を開いているかどうかじゃ
+	confirmAbandon bool // ランの放棄を確かめているかどうかじゃ
+}
+
+// openPauseScreen はポーズメニューを開くのじゃ
+// 掴んだカードとターン終了の確かめは取りやめるのじゃ
+func (c *GameController) openPauseScreen() {
+	c.pauseScreen = &pauseScreen{returnCursor: c.cursorPosition}
+	c.endTurnPrompt = false
+	c.targeting = false
+	c.cursorPosition = 0
+}
+
+// closePauseScreen はポーズメニューを閉じて元の画面のカーソル位置に戻すのじゃ
+func (c *GameController) closePauseScreen() {
+	c.cursorPosition = c.pauseScreen.returnCursor
+	c.pauseScreen = nil
+}
+
+// backPauseScreen はポーズメニューで1つ前に戻るのじゃ
+// 設定画面と放棄の確かめはポーズメニューに、ポーズメニューはランに戻るのじゃ
+func (c *GameController) backPauseScreen() {
+	switch {
+	case c.pauseScreen.settings:
+		c.closeSettingsScreen()
+	case c.pauseScreen.confirmAbandon:
+		c.pauseScreen.confirmAbandon = false
+		c.cursorPosition = 0
+	default:
+		c.closePauseScreen()
+	}
+}
+
+// pauseItems は今選べるポーズメニューの項目を返すのじゃ
+// 行単位で遊ぶときは設定画面を開けないので、設定は並べないのじゃ
+func (c *GameController) pauseItems() []pauseItem {
+	if c.narrating() {
+		return []pauseItem{pauseResume, pauseSaveQuit, pauseAbandon}
+	}
+	return []pauseItem{pauseResume, pauseSettings, pauseSaveQuit, pauseAbandon}
+}
+
+// pauseChoices はポーズメニューに並べる選択肢の表示名を返すのじゃ
+// 放棄を確かめているときは、やめるか放棄するかの2つじゃ
+func (c *GameController) pauseChoices() []string {
+	if c.pauseScreen.confirmAbandon {
+		return []string{i18n.T("pause.abandon_no"), i18n.T("pause.abandon_yes")}
+	}
+	choices := []string{}
+	for _, item := range c.pauseItems() {
+		choices = append(choices, i18n.T(pauseItemNames[item]))
+	}
+	return choices
+}
+
+// pauseTitle はポーズメニューの見出しを返すのじゃ
+func (c *GameController) pauseTitle() string {
+	if c.pauseScreen.confirmAbandon {
+		return i18n.T("pause.abandon_title")
+	}
+	return i18n.T("pause.title")
+}
+
+// handlePauseEvents はポーズメニューのイベントを処理するのじゃ
+// 上下で項目を選び、決定で選んだ項目を実行するのじゃ
+func (c *GameController) handlePauseEvents(action Action) {
+	c.moveCursor(action, ActionUp, ActionDown)
+	if c.pauseScreen.settings {
+		c.handleSettingsEvents(action)
+		return
+	}
+	if action == ActionConfirm {
+		c.selectPauseItem(c.cursorPosition)
+	}
+}
+
+// selectPauseItem はポーズメニューの項目を番号で選ぶのじゃ
+// 中断データは部屋に入るときとマップに戻るときに保存済みなので、保存して終えるときはそこから再開するのじゃ
+func (c *GameController) selectPauseItem(index int) {
+	if c.pauseScreen.confirmAbandon {
+		if index == 1 {
+			c.gameInteractor.AbandonRun()
+			c.leaveRun()
+			return
+		}
+		c.backPauseScreen()
+		return
+	}
+
+	items := c.pauseItems()
+	if index < 0 || index >= len(items) {
+		return
+	}
+	switch items[index] {
+	case pauseResume:
+		c.closePauseScreen()
+	case pauseSettings:
+		c.pauseScreen.settings = true
+		c.cursorPosition = 0
+	case pauseSaveQuit:
+		c.gameInteractor.SuspendRun()
+		c.leaveRun()
+	case pauseAbandon:
+		c.pauseScreen.confirmAbandon = true
+		c.cursorPosition = 0
+	}
+}
+
+// ポーズメニューを描画する関数じゃ
+// ランの画面の上に小窓を重ね、放棄を確かめているときは注意を添えるのじゃ
+func (c *GameController) drawPauseScreen() {
+	choices := c.pauseChoices()
+	items := []listItem{}
+	for _, choice := range choices {
+		items = append(items, listItem{text: choice})
+	}
+
+	height := len(items) + 4
+	if c.pauseScreen.confirmAbandon {
+		height += 2
+	}
+	helpText := i18n.T("help.pause", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionCancel))
+	inner := c.drawDialog(44, height, c.pauseTitle(), helpText).Inset(2, 1)
+	if c.pauseScreen.confirmAbandon {
+		c.drawLabel(inner.Row(0), AlignCenter, StyleOf(DamageStyleType), i18n.T("pause.abandon_warning"))
+		_, inner = inner.SplitTop(2)
+	}
+	c.drawList(inner, items, AlignCenter, regionOption)
+}
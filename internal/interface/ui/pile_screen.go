@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// pileKind は一覧で表示するカードの束の種類じゃ
+type pileKind int
+
+// カードの束の種類の定義
+const (
+	pileDeck pileKind = iota
+	pileDraw
+	pileDiscard
+	pileExhaust
+)
+
+// カードの束の表示名のキーじゃ
+var pileNames = map[pileKind]string{
+	pileDeck:    "pile.deck",
+	pileDraw:    "pile.draw",
+	pileDiscard: "pile.discard",
+	pileExhaust: "pile.exhaust",
+}
+
+// cardSortKey はカードの並べ替えの基準じゃ
+type cardSortKey int
+
+// 並べ替えの基準の定義。oキーでこの順に切り替わるのじゃ
+const (
+	sortByCost cardSortKey = iota
+	sortByType
+	sortByRarity
+	sortByName
+	cardSortKeyCount
+)
+
+// 並べ替えの基準の表示名のキーじゃ
+var cardSortKeyNames = map[cardSortKey]string{
+	sortByCost:   "pile.sort_cost",
+	sortByType:   "pile.sort_type",
+	sortByRarity: "pile.sort_rarity",
+	sortByName:   "pile.sort_name",
+}
+
+// pileScreen はカードの束の一覧の状態を保持するのじゃ
+type pileScreen struct {
+	kind         pileKind
+	sortKey      cardSortKey
+	detail       bool // 選択中のカードの詳細を表示しているかどうかじゃ
+	returnCursor int  // 一覧を閉じたときに戻すカーソル位置じゃ
+}
+
+// pileHotkey は操作に対応するカードの束を返すのじゃ
+// 戦闘中でなければデッキしか見られないのじゃ
+func (c *GameController) pileHotkey(action Action) (pileKind, bool) {
+	switch {
+	case action == ActionViewDeck:
+		return pileDeck, true
+	case c.currentState() != entities.StateCombat:
+		return 0, false
+	case action == ActionViewDraw:
+		return pileDraw, true
+	case action == ActionViewDiscard:
+		return pileDiscard, true
+	case action == ActionViewExhaust:
+		return pileExhaust, true
+	}
+	return 0, false
+}
+
+// canViewPiles はカードの束の一覧を開ける場面かを判定するのじゃ
+func (c *GameController) canViewPiles() bool {
+	switch c.currentState() {
+	case entities.StateMap, entities.StateCombat, entities.StateReward, entities.StateRest:
+		return true
+	}
+	return false
+}
+
+// openPileScreen はカードの束の一覧を開くのじゃ
+func (c *GameController) openPileScreen(kind pileKind) {
+	c.pileScreen = &pileScreen{kind: kind, sortKey: sortByCost, returnCursor: c.cursorPosition}
+	c.cursorPosition = 0
+}
+
+// closePileScreen はカードの束の一覧を閉じて元の画面のカーソル位置に戻すのじゃ
+func (c *GameController) closePileScreen() {
+	c.cursorPosition = c.pileScreen.returnCursor
+	c.pileScreen = nil
+}
+
+// handlePileEvents はカードの束の一覧のイベントを処理するのじゃ
+func (c *GameController) handlePileEvents(action Action) {
+	// 同じキーなら閉じ、別の束のキーならそちらに切り替えるのじゃ
+	if kind, ok := c.pileHotkey(action); ok {
+		if kind == c.pileScreen.kind {
+			c.closePileScreen()
+		} else {
+			c.pileScreen.kind = kind
+			c.pileScreen.detail = false
+			c.cursorPosition = 0
+		}
+		return
+	}
+
+	if c.pileScreen.detail {
+		// 詳細表示中は決定キーで一覧に戻るのじゃ
+		if action == ActionConfirm {
+			c.pileScreen.detail = false
+		}
+		return
+	}
+
+	if action == ActionSort {
+		c.pileScreen.sortKey = (c.pileScreen.sortKey + 1) % cardSortKeyCount
+		return
+	}
+
+	// 戦闘中に開いても一覧は縦に並んでいるので、上下で選ぶのじゃ
+	c.moveCursor(action, ActionUp, ActionDown)
+	if action == ActionConfirm && c.cursorPosition < len(c.pileCards()) {
+		c.pileScreen.detail = true
+	}
+}
+
+// pileCards は一覧に表示するカードを並べ替えて返すのじゃ
+func (c *GameController) pileCards() []entities.Card {
+	return c.sortedPile(c.pileScreen.kind, c.pileScreen.sortKey)
+}
+
+// sortedPile はカードの束を並べ替えて返すのじゃ
+// 山札の順番が分からないよう、どの束も必ず並べ替えてから見せるのじゃ
+func (c *GameController) sortedPile(kind pileKind, key cardSortKey) []entities.Card {
+	player := c.gameInteractor.Player
+	var pile []entities.Card
+	switch kind {
+	case pileDeck:
+		pile = player.Deck
+	case pileDraw:
+		pile = player.DrawPile
+	case pileDiscard:
+		pile = player.DiscardPile
+	case pileExhaust:
+		pile = player.ExhaustPile
+	}
+
+	cards := append([]entities.Card{}, pile...)
+	sort.SliceStable(cards, func(a, b int) bool {
+		return cardLess(cards[a], cards[b], key)
+	})
+	return cards
+}
+
+// cardLess は並べ替えの基準でカードaがカードbより前に来るかを判定するのじゃ
+// 基準で並びが決まらなければ名前で比べるのじゃ
+func cardLess(a, b entities.Card, key cardSortKey) bool {
+	switch key {
+	case sortByCost:
+		if a.EnergyCost != b.EnergyCost {
+			return a.EnergyCost < b.EnergyCost
+		}
+	case sortByType:
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+	case sortByRarity:
+		if a.Rarity != b.Rarity {
+			return a.Rarity > b.Rarity
+		}
+	}
+	return a.Name.String() < b.Name.String()
+}
+
+// pileCardCost はカードの束の一覧に出すコストを返すのじゃ
+// 戦闘中の束は手札と同じく今の補正を入れたコストにし、デッキは元のコストのままにするのじゃ
+func (c *GameController) pileCardCost(kind pileKind, card entities.Card) int {
+	if kind == pileDeck || c.currentState() != entities.StateCombat {
+		return card.EnergyCost
+	}
+	return c.gameInteractor.Player.GetCardCost(card)
+}
+
+// カードの束の一覧を描画する関数じゃ
+// 元の画面の上に小窓を重ねて表示するのじゃ
+func (c *GameController) drawPileScreen(area Rect) {
+	cards := c.pileCards()
+	title := i18n.T("pile.title", i18n.T(pileNames[c.pileScreen.kind]), i18n.Plural("count.cards", len(cards)), i18n.T(cardSortKeyNames[c.pileScreen.sortKey]))
+	helpText := i18n.T("help.pile", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionSort), c.keymap.Label(ActionViewDeck))
+	if c.currentState() == entities.StateCombat {
+		helpText = i18n.T("help.pile_combat",
+			c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionSort), c.keymap.Label(ActionViewDeck), c.keymap.Label(ActionViewDraw), c.keymap.Label(ActionViewDiscard), c.keymap.Label(ActionViewExhaust))
+	}
+	inner := c.drawDialog(area.Width-8, area.Height-4, title, helpText).Inset(2, 1)
+
+	if len(cards) == 0 {
+		c.drawLabel(inner.Row(inner.Height/2), AlignCenter, DefaultStyle(), i18n.T("pile.empty"))
+	} else if c.pileScreen.detail {
+		c.drawCardDetail(inner, cards[c.cursorPosition])
+	} else {
+		items := make([]listItem, 0, len(cards))
+		for _, card := range cards {
+			text := fmt.Sprintf("%2d %s %s %s", c.pileCardCost(c.pileScreen.kind, card), runewidth.FillRight(card.Name.String(), 20), runewidth.FillRight(card.GetTypeName().String(), 10), card.GetRarityName())
+			items = append(items, listItem{text: text, style: RarityStyle(card.Rarity)})
+		}
+		c.drawList(inner, items, AlignLeft, regionOption)
+	}
+}
+
+// カードの詳細を描画する関数じゃ
+// 戦闘中なら今の状態で補正した数値も表示するのじゃ
+func (c *GameController) drawCardDetail(r Rect, card entities.Card) {
+	player := c.gameInteractor.Player
+	var enemy *entities.Enemy
+	if c.currentState() == entities.StateCombat {
+		enemy = c.gameInteractor.Enemy
+	}
+	c.drawSubject(r, cardSubject(card, player, enemy))
+}
@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"errors"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// rewardState は報酬画面の状態を保持するのじゃ
+// 報酬の一覧から1つずつ受け取り、カード報酬はカードの一覧を開いて1枚を選ぶのじゃ
+type rewardState struct {
+	choosing     bool   // カード報酬のカードを選んでいるかどうかじゃ
+	index        int    // カードを選んでいるカード報酬の番号じゃ
+	confirmLeave bool   // 報酬を残して先に進むかを確かめているかどうかじゃ
+	notice       string // 報酬を受け取れなかったときの知らせじゃ
+}
+
+// rewardLabel は報酬の一覧に並べる報酬の表示名を返すのじゃ
+func rewardLabel(reward entities.Reward) string {
+	switch reward.Kind {
+	case entities.RewardGold:
+		return i18n.T("reward.gold", i18n.Number(reward.Gold))
+	case entities.RewardPotion:
+		return i18n.T("reward.potion", reward.Potion.Name)
+	case entities.RewardRelic:
+		return i18n.T("reward.relic", reward.Relic.Name, reward.Relic.Description)
+	default:
+		return i18n.T("reward.card_reward")
+	}
+}
+
+// rewardChoices は報酬画面で選べる選択肢の表示名を返すのじゃ
+// 報酬の一覧では、残っている報酬の後に先に進む選択肢を並べるのじゃ
+func (c *GameController) rewardChoices() []string {
+	switch {
+	case c.reward.confirmLeave:
+		return []string{i18n.T("reward.leave_no"), i18n.T("reward.leave_yes")}
+	case c.reward.choosing:
+		choices := []string{}
+		for _, card := range c.gameInteractor.Rewards[c.reward.index].Cards {
+			choices = append(choices, i18n.T("reward.card", card.Name, card.EnergyCost, card.Description))
+		}
+		return choices
+	}
+	return c.rewardList()
+}
+
+// rewardList は残っている報酬の表示名と、先に進む選択肢を並べるのじゃ
+func (c *GameController) rewardList() []string {
+	list := []string{}
+	for _, reward := range c.gameInteractor.Rewards {
+		list = append(list, rewardLabel(reward))
+	}
+	return append(list, i18n.T("reward.proceed"))
+}
+
+// rewardTitle は報酬画面の見出しを返すのじゃ
+func (c *GameController) rewardTitle() string {
+	switch {
+	case c.reward.confirmLeave:
+		return i18n.T("reward.leave_title")
+	case c.reward.choosing:
+		return i18n.T("reward.cards")
+	default:
+		return i18n.T("reward.victory")
+	}
+}
+
+// handleRewardEvents は報酬画面のイベントを処理するのじゃ
+// 決定で選んでいる報酬を受け取り、飛ばす操作で捨てるのじゃ
+func (c *GameController) handleRewardEvents(action Action) {
+	switch action {
+	case ActionConfirm:
+		c.selectRewardOption(c.cursorPosition)
+	case ActionSkip:
+		c.skipRewardOption(c.cursorPosition)
+	}
+}
+
+// selectRewardOption は報酬画面の選択肢を番号で選ぶのじゃ
+// カード報酬を選ぶとカードの一覧を開き、先に進むときは残った報酬があれば確かめるのじゃ
+// 受け取れなかったときは理由を知らせて返すのじゃ
+func (c *GameController) selectRewardOption(index int) error {
+	c.reward.notice = ""
+	rewards := c.gameInteractor.Rewards
+
+	switch {
+	case c.reward.confirmLeave:
+		c.reward.confirmLeave = false
+		if index == 1 {
+			c.gameInteractor.LeaveRewards()
+		}
+		c.afterReward(len(rewards))
+
+	case c.reward.choosing:
+		if c.gameInteractor.SelectRewardCard(c.reward.index, index) {
+			c.reward.choosing = false
+			c.afterReward(c.reward.index)
+		}
+
+	case index == len(rewards):
+		c.reward.confirmLeave = true
+		c.cursorPosition = 0
+
+	case index >= 0 && index < len(rewards):
+		if rewards[index].Kind == entities.RewardCards {
+			c.reward.choosing = true
+			c.reward.index = index
+			c.cursorPosition = 0
+			return nil
+		}
+		if !c.gameInteractor.ClaimReward(index) {
+			c.reward.notice = i18n.T("reward.potion_full")
+			return errors.New(c.reward.notice)
+		}
+		c.afterReward(index)
+	}
+	return nil
+}
+
+// skipRewardOption は選んでいる報酬を受け取らずに捨てるのじゃ
+// カードを選んでいるときは、そのカード報酬を捨てて一覧に戻るのじゃ
+func (c *GameController) skipRewardOption(index int) {
+	c.reward.notice = ""
+	switch {
+	case c.reward.confirmLeave:
+		return
+	case c.reward.choosing:
+		c.reward.choosing = false
+		index = c.reward.index
+	}
+	if c.gameInteractor.SkipReward(index) {
+		c.afterReward(index)
+	}
+}
+
+// backRewardScreen はカードの一覧か先に進む確かめから、報酬の一覧に戻るのじゃ
+func (c *GameController) backRewardScreen() {
+	if c.reward.choosing {
+		c.reward.choosing = false
+		c.cursorPosition = c.reward.index
+		return
+	}
+	c.reward.confirmLeave = false
+	c.cursorPosition = len(c.gameInteractor.Rewards)
+}
+
+// afterReward は報酬を受け取るか捨てた後のカーソルを置き直すのじゃ
+// 報酬が無くなってマップに戻ったら、報酬画面の状態を片付けるのじゃ
+func (c *GameController) afterReward(index int) {
+	if c.currentState() != entities.StateReward {
+		c.reward = rewardState{}
+		c.cursorPosition = 0
+		return
+	}
+	c.cursorPosition = min(index, len(c.gameInteractor.Rewards))
+}
+
+// 報酬画面を描画する関数じゃ
+// 見出しの下に今の体力とゴールドを出し、報酬の一覧かカード報酬のカードを並べるのじゃ
+func (c *GameController) drawRewardScreen(area Rect) {
+	player := c.gameInteractor.Player
+	helpText := i18n.T("help.reward", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionSkip), c.keymap.Label(ActionViewDeck), c.keymap.Label(ActionInspect), c.keymap.Label(ActionCancel))
+	if c.reward.choosing {
+		helpText = i18n.T("help.reward_cards", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionSkip), c.keymap.Label(ActionInspect), c.keymap.Label(ActionCancel))
+	}
+	title := i18n.T("reward.victory")
+	if c.reward.choosing {
+		title = i18n.T("reward.cards")
+	}
+	body := c.drawPage(area, title, helpText)
+	c.drawLabel(body.Row(0), AlignCenter, DefaultStyle(), i18n.T("map.player", player.Health, player.MaxHealth, i18n.Number(player.Gold)))
+
+	// 報酬の一覧か、カード報酬のカードを並べるのじゃ
+	items := []listItem{}
+	for i, choice := range c.rewardChoices() {
+		item := listItem{text: choice}
+		if c.reward.choosing {
+			item.style = RarityStyle(c.gameInteractor.Rewards[c.reward.index].Cards[i].Rarity)
+		}
+		items = append(items, item)
+	}
+	_, list := body.SplitTop(2)
+	list = list.Inset(1, 0)
+	if !c.reward.confirmLeave {
+		c.drawList(list, items, AlignCenter, regionOption)
+	} else {
+		// 確かめている間は、残っている報酬を選べないように並べるだけにするのじゃ
+		for i, text := range c.rewardList() {
+			c.drawLabel(list.Row(i), AlignCenter, DefaultStyle(), text)
+		}
+	}
+
+	if c.reward.notice != "" {
+		c.drawNotice(area, c.reward.notice)
+	}
+
+	// 残った報酬を置いていくかは小窓で確かめるのじゃ
+	if c.reward.confirmLeave {
+		helpText := i18n.T("help.pause", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionCancel))
+		inner := c.drawDialog(48, 8, c.rewardTitle(), helpText).Inset(2, 1)
+		c.drawLabel(inner.Row(0), AlignCenter, DefaultStyle(), i18n.N("reward.leave_warning", len(c.gameInteractor.Rewards)))
+		_, inner = inner.SplitTop(2)
+		c.drawList(inner, items, AlignCenter, regionOption)
+	}
+}
@@ -7,27 +7,35 @@ type ScreenPort interface {
 	DrawText(x, y int, style Style, text string)
 	GetSize() (width int, height int)
 	PollEvent() EventPort
-	Sleep(ms int)
+	// Interrupt はPollEventで待っているのを起こすのじゃ。別のゴルーチンから呼んでもよいのじゃ
+	Interrupt()
 	Cleanup()
+	// Themes は選べる配色のテーマの名前を返すのじゃ
+	Themes() []string
+	// SetTheme は配色のテーマを切り替えるのじゃ。名前が空ならその場の環境に合わせるのじゃ
+	SetTheme(name string) error
+	// SetKeyBindings はキーの割り当てを切り替えるのじゃ
+	SetKeyBindings(bindings KeyBindings)
 }
 
 // EventPort はイベントのインターフェースを定義するのじゃ
 type EventPort interface {
-	IsExit() bool
-	IsEndTurn() bool
+	// Action は押されたキーに割り当てられた操作を返すのじゃ。割り当てが無ければActionNoneじゃ
+	Action() Action
 	IsAnyKey() bool
-	GetCardIndex() int
 	IsResize() bool
-	IsSKey() bool
-	IsKey1() bool
-	IsKey2() bool
-	// Vim風の操作に必要なイベント判定を追加するのじゃ
-	IsUp() bool
-	IsDown() bool
-	IsLeft() bool
-	IsRight() bool
-	IsEnter() bool
-	IsSpace() bool
+	// マウスの操作の判定じゃ。座標は画面の文字単位じゃ
+	IsMouse() bool
+	IsClick() bool
+	MousePosition() (x, y int)
+	// Command は行単位で打ち込まれた命令を返すのじゃ。命令でなければfalseじゃ
+	Command() (Command, bool)
+}
+
+// NarratorPort は画面の様子を文章で伝えるスクリーンのインターフェースじゃ
+// 行単位で遊ぶスクリーンが実装し、命令を処理するたびに今の様子の説明を受け取るのじゃ
+type NarratorPort interface {
+	Narrate(lines []string)
 }
 
 // Style は表示スタイルを表すのじゃ
@@ -35,12 +43,34 @@ type Style interface {
 	// スタイル関連のメソッドが必要になれば追加するのじゃ
 }
 
-// スタイル定義用の定数を追加するのじゃ
+// StyleType は表示するものの意味を表すスタイルの種類じゃ
+// 実際の色はスクリーンの実装がテーマに従って決めるのじゃ
 type StyleType int
 
 const (
 	DefaultStyleType StyleType = iota
 	SelectedStyleType
+	DisabledStyleType
+	HPStyleType
+	BlockStyleType
+	EnergyStyleType
+	DamageStyleType
+	BuffStyleType
+	DebuffStyleType
+	CommonStyleType
+	UncommonStyleType
+	RareStyleType
+	AttackStyleType
+	SkillStyleType
+	PowerStyleType
+	CurseStyleType
+	EnemyNodeStyleType
+	EliteNodeStyleType
+	BossNodeStyleType
+	RestNodeStyleType
+	ShopNodeStyleType
+	TreasureNodeStyleType
+	EventNodeStyleType
 )
 
 // StyleTypeContainer はスタイルの種類を保持する構造体じゃ
@@ -48,12 +78,22 @@ type StyleTypeContainer struct {
 	Type StyleType
 }
 
+// StyleOf は指定された種類のスタイルを返すのじゃ
+func StyleOf(styleType StyleType) Style {
+	return &StyleTypeContainer{Type: styleType}
+}
+
 // DefaultStyle はデフォルトスタイルを返すのじゃ
 func DefaultStyle() Style {
-	return &StyleTypeContainer{Type: DefaultStyleType}
+	return StyleOf(DefaultStyleType)
 }
 
 // SelectedStyle は選択中のスタイルを返すのじゃ
 func SelectedStyle() Style {
-	return &StyleTypeContainer{Type: SelectedStyleType}
+	return StyleOf(SelectedStyleType)
+}
+
+// DisabledStyle は使えないものを暗く表示するスタイルを返すのじゃ
+func DisabledStyle() Style {
+	return StyleOf(DisabledStyleType)
 }
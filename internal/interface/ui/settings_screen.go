@@ -0,0 +1,239 @@
+package ui
+
+import (
+	"errors"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// settingItem は設定画面に並べる1つの項目じゃ
+type settingItem struct {
+	label string // 項目の名前のキーじゃ
+	value string // 今の値の表示名じゃ
+	// change は左右キーで値を1つずらした設定を返すのじゃ
+	change func(settings entities.Settings, step int) entities.Settings
+}
+
+// UseSettings は設定の保存先とキーマップの読み込み方を決め、設定を当てはめるのじゃ
+func (c *GameController) UseSettings(interactor *usecase.SettingsInteractor, loadKeymap KeymapLoader, settings entities.Settings) error {
+	c.settingsInteractor = interactor
+	c.loadKeymap = loadKeymap
+	return c.applySettings(settings)
+}
+
+// applySettings は設定をその場で画面と操作に当てはめるのじゃ
+// 当てはめられない値があれば、何も変えずにエラーを返すのじゃ
+func (c *GameController) applySettings(settings entities.Settings) error {
+	speed, err := ParseAnimationSpeed(settings.Animation)
+	if err != nil {
+		return err
+	}
+	if err := checkFrameRate(settings.FrameRate); err != nil {
+		return err
+	}
+	lang := i18n.CurrentLang()
+	if settings.Language != "" {
+		if lang, err = i18n.ParseLang(settings.Language); err != nil {
+			return err
+		}
+	}
+
+	// キーマップは読み込み方が決まっていなければ組み込みのものを使うのじゃ
+	keymap := c.keymap
+	if c.loadKeymap != nil {
+		if keymap, err = c.loadKeymap(settings.Keymap); err != nil {
+			return err
+		}
+	} else if settings.Keymap != "" {
+		preset, ok := KeymapPreset(settings.Keymap)
+		if !ok {
+			return errors.New(i18n.T("error.unknown_keymap", settings.Keymap, KeymapPresetNames()))
+		}
+		keymap = preset
+	}
+	bindings, err := keymap.Bindings()
+	if err != nil {
+		return err
+	}
+	if err := c.screen.SetTheme(settings.Theme); err != nil {
+		return err
+	}
+
+	i18n.SetLang(lang)
+	c.screen.SetKeyBindings(bindings)
+	c.keymap = keymap
+	c.animator.speed = speed
+	c.animator.fastTurns = settings.FastEnemyTurns
+	c.settings = settings
+	return nil
+}
+
+// changeSettings は設定を変えてすぐに当てはめ、保存するのじゃ
+// 失敗したら知らせを出し、当てはめられなかった設定は元のままにするのじゃ
+func (c *GameController) changeSettings(settings entities.Settings) {
+	c.settingsErr = c.applySettings(settings)
+	if c.settingsErr == nil && c.settingsInteractor != nil {
+		c.settingsErr = c.settingsInteractor.SaveSettings(c.settings)
+	}
+}
+
+// settingItems は設定画面に並べる項目を返すのじゃ
+// 名前が空の設定は、その場の環境に合わせる「自動」として表示するのじゃ
+func (c *GameController) settingItems() []settingItem {
+	return []settingItem{
+		{
+			label: "settings.language",
+			value: i18n.CurrentLang().Label(),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.Language = cycleValue(i18n.LangNames(), string(i18n.CurrentLang()), step)
+				return s
+			},
+		},
+		{
+			label: "settings.theme",
+			value: autoLabel(c.settings.Theme),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.Theme = cycleValue(append([]string{""}, c.screen.Themes()...), s.Theme, step)
+				return s
+			},
+		},
+		{
+			label: "settings.animation",
+			value: c.animator.speed.Label(),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.Animation = cycleValue(AnimationSpeedNames(), s.Animation, step)
+				return s
+			},
+		},
+		{
+			label: "settings.frame_rate",
+			value: i18n.T("settings.fps", c.settings.FrameRate),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.FrameRate = cycleValue(frameRates, s.FrameRate, step)
+				return s
+			},
+		},
+		{
+			label: "settings.keymap",
+			value: autoLabel(c.settings.Keymap),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.Keymap = cycleValue(append([]string{""}, KeymapPresetNames()...), s.Keymap, step)
+				return s
+			},
+		},
+		{
+			label: "settings.confirm_end_turn",
+			value: onOffLabel(c.settings.ConfirmEndTurn),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.ConfirmEndTurn = !s.ConfirmEndTurn
+				return s
+			},
+		},
+		{
+			label: "settings.fast_enemy_turns",
+			value: onOffLabel(c.settings.FastEnemyTurns),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.FastEnemyTurns = !s.FastEnemyTurns
+				return s
+			},
+		},
+		{
+			label: "settings.damage_preview",
+			value: onOffLabel(c.settings.DamagePreview),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.DamagePreview = !s.DamagePreview
+				return s
+			},
+		},
+		{
+			label: "settings.log_verbosity",
+			value: c.settings.LogVerbosity.Label().String(),
+			change: func(s entities.Settings, step int) entities.Settings {
+				s.LogVerbosity = s.LogVerbosity.Next(step)
+				return s
+			},
+		},
+	}
+}
+
+// cycleValue は値の一覧の中で、今の値からstepだけずらした値を返すのじゃ
+// 今の値が一覧に無ければ先頭から数えるのじゃ
+func cycleValue[T comparable](values []T, current T, step int) T {
+	if len(values) == 0 {
+		return current
+	}
+	index := 0
+	for i, value := range values {
+		if value == current {
+			index = i
+		}
+	}
+	return values[(index+step+len(values))%len(values)]
+}
+
+// autoLabel は設定の名前を表示するのじゃ。空なら「自動」じゃ
+func autoLabel(name string) string {
+	if name == "" {
+		return i18n.T("settings.auto")
+	}
+	return name
+}
+
+// onOffLabel は切り替えの設定の表示名を返すのじゃ
+func onOffLabel(on bool) string {
+	if on {
+		return i18n.T("settings.on")
+	}
+	return i18n.T("settings.off")
+}
+
+// closeSettingsScreen は設定画面を閉じ、開いたメニューかポーズメニューに戻るのじゃ
+func (c *GameController) closeSettingsScreen() {
+	if c.pauseScreen != nil {
+		c.pauseScreen.settings = false
+		c.cursorPosition = 0
+		return
+	}
+	c.closeMenuScreen()
+}
+
+// handleSettingsEvents は設定画面のイベントを処理するのじゃ
+// 上下で項目を選び、左右キーで値を切り替え、決定でメニューに戻るのじゃ
+// 切り替えた値はすぐに当てはめて保存するのじゃ
+func (c *GameController) handleSettingsEvents(action Action) {
+	if action == ActionConfirm {
+		c.closeSettingsScreen()
+		return
+	}
+
+	step := 0
+	if action == ActionLeft {
+		step = -1
+	} else if action == ActionRight {
+		step = 1
+	}
+	items := c.settingItems()
+	if step == 0 || c.cursorPosition < 0 || c.cursorPosition >= len(items) {
+		return
+	}
+	c.changeSettings(items[c.cursorPosition].change(c.settings, step))
+}
+
+// 設定画面を描画する関数じゃ
+func (c *GameController) drawSettingsScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("settings.title"), i18n.T("help.settings", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionLeft, ActionRight), c.keymap.Label(ActionConfirm, ActionCancel)))
+
+	// 設定の項目を並べるのじゃ
+	items := []listItem{}
+	for _, item := range c.settingItems() {
+		items = append(items, listItem{text: i18n.T(item.label, item.value)})
+	}
+	_, list := body.Inset(6, 0).SplitTop(1)
+	c.drawList(list, items, AlignLeft, regionOption)
+
+	if c.settingsErr != nil {
+		c.drawNotice(area, i18n.T("error.settings_apply", c.settingsErr))
+	}
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// setupScreen はキャラクターとアセンションの選択画面の状態を保持するのじゃ
+type setupScreen struct {
+	characters   []string // 選べるキャラクターのIDじゃ。空はラン開始時に選ぶことを表すのじゃ
+	maxAscension int
+	err          error
+}
+
+// openSetupScreen はキャラクターとアセンションの選択画面を開くのじゃ
+func (c *GameController) openSetupScreen() {
+	profile, err := c.profileInteractor.GetProfile()
+
+	characters := []string{""}
+	for _, character := range entities.AllCharacters() {
+		if err == nil && profile.IsCharacterUnlocked(character.ID) {
+			characters = append(characters, character.ID)
+		}
+	}
+
+	c.menu.setup = &setupScreen{characters: characters, maxAscension: profile.MaxAscension, err: err}
+	c.cursorPosition = 0
+}
+
+// handleSetupEvents はキャラクターとアセンションの選択画面のイベントを処理するのじゃ
+// 1行目でキャラクター、2行目でアセンションを左右キーで切り替えるのじゃ
+func (c *GameController) handleSetupEvents(action Action) {
+	setup := c.menu.setup
+	if action == ActionConfirm {
+		c.closeMenuScreen()
+		return
+	}
+
+	step := 0
+	if action == ActionLeft {
+		step = -1
+	} else if action == ActionRight {
+		step = 1
+	}
+	if step == 0 {
+		return
+	}
+
+	switch c.cursorPosition {
+	case 0:
+		index := 0
+		for i, id := range setup.characters {
+			if id == c.menuInteractor.Character {
+				index = i
+			}
+		}
+		index = (index + step + len(setup.characters)) % len(setup.characters)
+		setup.err = c.menuInteractor.SetCharacter(setup.characters[index])
+	case 1:
+		level := max(0, min(setup.maxAscension, c.menuInteractor.Ascension+step))
+		setup.err = c.menuInteractor.SetAscension(level)
+	}
+}
+
+// setupItems はランの設定画面に並べる、キャラクターとアセンションの2行を返すのじゃ
+func (c *GameController) setupItems() []listItem {
+	characterName := i18n.T("setup.choose_later")
+	if character, ok := entities.GetCharacterByID(c.menuInteractor.Character); ok {
+		characterName = character.Name.String()
+	}
+	return []listItem{
+		{text: i18n.T("setup.character", characterName)},
+		{text: i18n.T("setup.ascension", c.menuInteractor.Ascension, c.menu.setup.maxAscension)},
+	}
+}
+
+// キャラクターとアセンションの選択画面を描画する関数じゃ
+func (c *GameController) drawSetupScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("setup.title"), i18n.T("help.setup", c.keymap.Label(ActionUp, ActionDown), c.keymap.Label(ActionLeft, ActionRight), c.keymap.Label(ActionConfirm), c.keymap.Label(ActionCancel)))
+
+	characterDescription := i18n.T("setup.choose_later_description")
+	if character, ok := entities.GetCharacterByID(c.menuInteractor.Character); ok {
+		characterDescription = character.Description.String()
+	}
+	ascension := c.menuInteractor.Ascension
+
+	// キャラクターとアセンションの2行を並べ、その下に選んでいるものの説明を書くのじゃ
+	items := c.setupItems()
+	rows := body.Inset(6, 0).Rows(1, len(items), 1, 1, 1)
+	c.drawList(rows[1], items, AlignLeft, regionOption)
+	c.drawLabel(rows[3], AlignLeft, DefaultStyle(), characterDescription)
+	if ascension > 0 {
+		c.drawLabel(rows[4], AlignLeft, DefaultStyle(), entities.GetAscensionDescription(ascension).String())
+	}
+
+	if c.menu.setup.err != nil {
+		c.drawNotice(area, i18n.T("error.setup", c.menu.setup.err))
+	}
+}
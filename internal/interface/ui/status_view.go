@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// 体力の棒の幅じゃ
+const healthBarWidth = 10
+
+// statusLabel は状態の欄に出す短い表記を作るのじゃ
+// 重ねた数はそのまま、残りのターン数は括弧で囲み、戦闘の終わりまで続くパワーは∞にするのじゃ
+func statusLabel(status entities.Status) string {
+	switch {
+	case status.IsPermanent():
+		return status.Name.String() + "(∞)"
+	case status.Kind == entities.StatusStacks:
+		return fmt.Sprintf("%s%d", status.Name, status.Amount)
+	default:
+		return fmt.Sprintf("%s(%d)", status.Name, status.Amount)
+	}
+}
+
+// statusStyle は状態を描くスタイルを返すのじゃ
+func statusStyle(status entities.Status) Style {
+	switch {
+	case status.Debuff:
+		return StyleOf(DebuffStyleType)
+	case status.Kind == entities.StatusPower:
+		return StyleOf(PowerStyleType)
+	default:
+		return StyleOf(BuffStyleType)
+	}
+}
+
+// 状態の欄を描画する関数じゃ
+// 幅に収まらなければ次の行に折り返し、使った行数を返すのじゃ
+// クリックすると詳細を開けるよう、状態ごとに場所を登録するのじゃ
+func (c *GameController) drawStatusRow(r Rect, statuses []entities.Status, firstIndex int) int {
+	if len(statuses) == 0 {
+		return 0
+	}
+
+	rows, col := 1, 0
+	for i, status := range statuses {
+		label := statusLabel(status)
+		if col > 0 && col+textWidth(label) > r.Width {
+			rows, col = rows+1, 0
+		}
+		_, cell := r.Row(rows - 1).SplitLeft(col)
+		drawn := c.drawLabel(cell, AlignLeft, statusStyle(status), label)
+		c.addRegion(drawn, regionStatus, firstIndex+i)
+		col += drawn.Width + 1
+	}
+	return rows
+}
+
+// 体力の棒を描画する関数じゃ
+// ブロックがあれば、残りの体力の右端からブロックの分を重ねて塗り、数値も添えるのじゃ
+func (c *GameController) drawHealthBar(r Rect, health, maxHealth, block int) {
+	filled := 0
+	if health > 0 && maxHealth > 0 {
+		filled = min(healthBarWidth, max(1, health*healthBarWidth/maxHealth))
+	}
+	shielded := 0
+	if block > 0 && maxHealth > 0 {
+		shielded = min(filled, max(1, block*healthBarWidth/maxHealth))
+	}
+
+	bar, rest := r.SplitLeft(healthBarWidth)
+	c.drawLabel(bar, AlignLeft, StyleOf(HPStyleType), strings.Repeat("█", filled-shielded))
+	_, bar = bar.SplitLeft(filled - shielded)
+	c.drawLabel(bar, AlignLeft, StyleOf(BlockStyleType), strings.Repeat("▓", shielded))
+	_, bar = bar.SplitLeft(shielded)
+	c.drawLabel(bar, AlignLeft, DisabledStyle(), strings.Repeat("░", healthBarWidth-filled))
+
+	text := c.drawLabel(rest, AlignLeft, StyleOf(HPStyleType), fmt.Sprintf(" %d/%d", health, maxHealth))
+	if block > 0 {
+		_, rest = rest.SplitLeft(text.Width + 1)
+		c.drawLabel(rest, AlignLeft, StyleOf(BlockStyleType), i18n.T("combat.block", block))
+	}
+}
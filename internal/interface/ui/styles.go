@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// styleTypeNames はテーマファイルで使うスタイルの名前じゃ
+var styleTypeNames = map[StyleType]string{
+	DefaultStyleType:      "default",
+	SelectedStyleType:     "selected",
+	DisabledStyleType:     "disabled",
+	HPStyleType:           "hp",
+	BlockStyleType:        "block",
+	EnergyStyleType:       "energy",
+	DamageStyleType:       "damage",
+	BuffStyleType:         "buff",
+	DebuffStyleType:       "debuff",
+	CommonStyleType:       "common",
+	UncommonStyleType:     "uncommon",
+	RareStyleType:         "rare",
+	AttackStyleType:       "attack",
+	SkillStyleType:        "skill",
+	PowerStyleType:        "power",
+	CurseStyleType:        "curse",
+	EnemyNodeStyleType:    "node_enemy",
+	EliteNodeStyleType:    "node_elite",
+	BossNodeStyleType:     "node_boss",
+	RestNodeStyleType:     "node_rest",
+	ShopNodeStyleType:     "node_shop",
+	TreasureNodeStyleType: "node_treasure",
+	EventNodeStyleType:    "node_event",
+}
+
+// String はスタイルの種類の名前を返すのじゃ
+func (t StyleType) String() string {
+	return styleTypeNames[t]
+}
+
+// ParseStyleType は名前からスタイルの種類を求めるのじゃ
+func ParseStyleType(name string) (StyleType, bool) {
+	for styleType, n := range styleTypeNames {
+		if n == name {
+			return styleType, true
+		}
+	}
+	return DefaultStyleType, false
+}
+
+// StyleTypeOf はスタイルの種類を取り出すのじゃ。分からなければデフォルトじゃ
+func StyleTypeOf(style Style) StyleType {
+	if container, ok := style.(*StyleTypeContainer); ok {
+		return container.Type
+	}
+	return DefaultStyleType
+}
+
+// RarityStyle はカードのレア度のスタイルを返すのじゃ
+func RarityStyle(rarity entities.CardRarity) Style {
+	switch rarity {
+	case entities.Common:
+		return StyleOf(CommonStyleType)
+	case entities.Uncommon:
+		return StyleOf(UncommonStyleType)
+	case entities.Rare:
+		return StyleOf(RareStyleType)
+	default:
+		return DefaultStyle()
+	}
+}
+
+// CardTypeStyle はカードの種類のスタイルを返すのじゃ
+func CardTypeStyle(cardType entities.CardType) Style {
+	switch cardType {
+	case entities.AttackCard:
+		return StyleOf(AttackStyleType)
+	case entities.SkillCard:
+		return StyleOf(SkillStyleType)
+	case entities.PowerCard:
+		return StyleOf(PowerStyleType)
+	case entities.CurseCard:
+		return StyleOf(CurseStyleType)
+	default:
+		return DefaultStyle()
+	}
+}
+
+// NodeStyle はマップのノードの種類のスタイルを返すのじゃ
+func NodeStyle(nodeType entities.NodeType) Style {
+	switch nodeType {
+	case entities.NodeEnemy:
+		return StyleOf(EnemyNodeStyleType)
+	case entities.NodeElite:
+		return StyleOf(EliteNodeStyleType)
+	case entities.NodeBoss:
+		return StyleOf(BossNodeStyleType)
+	case entities.NodeRest:
+		return StyleOf(RestNodeStyleType)
+	case entities.NodeShop:
+		return StyleOf(ShopNodeStyleType)
+	case entities.NodeTreasure:
+		return StyleOf(TreasureNodeStyleType)
+	case entities.NodeEvent:
+		return StyleOf(EventNodeStyleType)
+	default:
+		return DefaultStyle()
+	}
+}
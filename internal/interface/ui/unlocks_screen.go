@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// unlocksScreen は解放状況の画面の状態を保持するのじゃ
+type unlocksScreen struct {
+	profile entities.Profile
+	err     error
+}
+
+// openUnlocksScreen は解放状況の画面を開くのじゃ
+func (c *GameController) openUnlocksScreen() {
+	profile, err := c.profileInteractor.GetProfile()
+	c.unlocksScreen = &unlocksScreen{profile: profile, err: err}
+}
+
+// handleUnlocksEvents は解放状況の画面のイベントを処理するのじゃ
+func (c *GameController) handleUnlocksEvents(action Action) {
+	if action == ActionUnlocks {
+		c.unlocksScreen = nil
+	}
+}
+
+// 解放状況の画面を描画する関数じゃ
+func (c *GameController) drawUnlocksScreen(area Rect) {
+	body := c.drawPage(area, i18n.T("unlocks.title"), "")
+	c.drawLabel(area.Row(-1).Inset(1, 0), AlignLeft, DefaultStyle(), i18n.T("help.overlay", c.keymap.Label(ActionUnlocks, ActionCancel)))
+
+	if c.unlocksScreen.err != nil {
+		c.drawLabel(body.Row(0).Inset(2, 0), AlignLeft, DefaultStyle(), i18n.T("error.profile_load", c.unlocksScreen.err))
+	} else {
+		c.drawUnlockProgress(body.Inset(4, 0))
+	}
+}
+
+// 次の段階までの進み具合と段階ごとの内容を描画する関数じゃ
+// 段階ごとに見出しと解放される内容の2行を書き、1行空けて次の段階に進むのじゃ
+func (c *GameController) drawUnlockProgress(r Rect) {
+	profile := c.unlocksScreen.profile
+
+	c.drawLabel(r.Row(0), AlignLeft, DefaultStyle(), i18n.T("unlocks.experience", profile.Experience))
+	if next := profile.NextTier(); next != nil {
+		barWidth := 30
+		filled := barWidth * profile.Experience / next.RequiredExperience
+		bar := "[" + strings.Repeat("#", filled) + strings.Repeat("-", barWidth-filled) + "]"
+		c.drawLabel(r.Row(1), AlignLeft, DefaultStyle(), i18n.T("unlocks.next_tier", bar, profile.Experience, next.RequiredExperience))
+	} else {
+		c.drawLabel(r.Row(1), AlignLeft, DefaultStyle(), i18n.T("unlocks.all_unlocked"))
+	}
+
+	_, tiers := r.SplitTop(3)
+	for idx, tier := range entities.GetUnlockTiers() {
+		block, rest := tiers.SplitTop(3)
+		tiers = rest
+		status := i18n.T("unlocks.locked")
+		if profile.Experience >= tier.RequiredExperience {
+			status = i18n.T("unlocks.unlocked")
+		}
+		c.drawLabel(block.Row(0), AlignLeft, DefaultStyle(), i18n.T("unlocks.tier", idx+1, tier.RequiredExperience, status))
+		c.drawLabel(block.Row(1).Inset(2, 0), AlignLeft, DefaultStyle(), describeUnlockTier(tier))
+	}
+}
+
+// describeUnlockTier は段階で解放されるコンテンツの名前を1行にまとめるのじゃ
+func describeUnlockTier(tier entities.UnlockTier) string {
+	names := []string{}
+	for _, id := range tier.Cards {
+		if card, ok := entities.CreateCardByID(id); ok {
+			names = append(names, i18n.T("unlocks.card", card.Name))
+		}
+	}
+	for _, id := range tier.Relics {
+		if relic, ok := entities.CreateRelicByID(id); ok {
+			names = append(names, i18n.T("unlocks.relic", relic.Name))
+		}
+	}
+	for _, id := range tier.Characters {
+		if character, ok := entities.GetCharacterByID(id); ok {
+			names = append(names, i18n.T("unlocks.character", character.Name))
+		}
+	}
+	return strings.Join(names, ", ")
+}
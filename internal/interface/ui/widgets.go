@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"strings"
+)
+
+// screenRect は画面全体の範囲を返すのじゃ
+func (c *GameController) screenRect() Rect {
+	width, height := c.screen.GetSize()
+	return Rect{Width: width, Height: height}
+}
+
+// drawLabel は範囲の1行目に文字列を寄せて描画し、描いた部分の範囲を返すのじゃ
+// 幅に収まらない文字列は…で切り詰めるのじゃ
+func (c *GameController) drawLabel(r Rect, align Align, style Style, text string) Rect {
+	if r.Width <= 0 || r.Height <= 0 || text == "" {
+		return Rect{X: r.X, Y: r.Y, Height: 1}
+	}
+	offset, text := alignText(text, r.Width, align)
+	c.screen.DrawText(r.X+offset, r.Y, style, text)
+	return Rect{X: r.X + offset, Y: r.Y, Width: textWidth(text), Height: 1}
+}
+
+// drawParagraph は文字列を範囲の幅で折り返して描画し、使った行数を返すのじゃ
+// 範囲の高さに収まらない分は、最後の行を…で終えて省くのじゃ
+func (c *GameController) drawParagraph(r Rect, style Style, text string) int {
+	lines := wrapText(text, r.Width)
+	if len(lines) > r.Height && r.Height > 0 {
+		lines = append(lines[:r.Height-1], truncateText(lines[r.Height-1]+"…", r.Width))
+	}
+	for i, line := range lines {
+		if i >= r.Height {
+			break
+		}
+		c.screen.DrawText(r.X, r.Y+i, style, line)
+	}
+	return min(len(lines), r.Height)
+}
+
+// drawPanel は罫線で囲んだ枠を描画し、枠の内側の範囲を返すのじゃ
+// 枠の内側は空白で塗りつぶすので、下に描かれたものの上に重ねられるのじゃ
+// 見出しがあれば上の罫線に重ねて書くのじゃ
+func (c *GameController) drawPanel(r Rect, title string, style Style) Rect {
+	if r.Width < 2 || r.Height < 2 {
+		return Rect{X: r.X, Y: r.Y}
+	}
+
+	inner := r.Width - 2
+	c.screen.DrawText(r.X, r.Y, style, "┌"+strings.Repeat("─", inner)+"┐")
+	for row := 1; row < r.Height-1; row++ {
+		c.screen.DrawText(r.X, r.Y+row, style, "│"+strings.Repeat(" ", inner)+"│")
+	}
+	c.screen.DrawText(r.X, r.Y+r.Height-1, style, "└"+strings.Repeat("─", inner)+"┘")
+
+	if title != "" {
+		c.drawLabel(Rect{X: r.X + 1, Y: r.Y, Width: inner, Height: 1}, AlignLeft, style, title)
+	}
+	return r.Inset(1, 1)
+}
+
+// drawDialog は画面の中央に枠付きの小窓を重ねて描画し、小窓の内側の範囲を返すのじゃ
+// 大きさは画面に収まるように縮め、操作説明は下の罫線に重ねて書くのじゃ
+func (c *GameController) drawDialog(width, height int, title, help string) Rect {
+	box := c.screenRect().Inset(1, 1).Center(width, height)
+	inner := c.drawPanel(box, title, DefaultStyle())
+	if help != "" {
+		c.drawLabel(box.Row(-1).Inset(1, 0), AlignLeft, DefaultStyle(), help)
+	}
+	return inner
+}
+
+// listItem は一覧に並べる1行じゃ
+type listItem struct {
+	text  string
+	style Style // 選ばれていないときのスタイルじゃ。nilなら既定のスタイルじゃ
+}
+
+// listWindow は一覧の何番目から何行を表示するかを求めるのじゃ
+// カーソルが真ん中に来るように動かし、端ではそれ以上動かさないのじゃ
+func listWindow(count, cursor, visible int) int {
+	return max(0, min(cursor-visible/2, count-visible))
+}
+
+// drawList は一覧を範囲に収めて描画するのじゃ
+// カーソルが見える範囲だけをスクロールして表示し、上下に続きがあれば右端に印を付けるのじゃ
+// 行ごとにクリックできる場所を登録するのじゃ
+func (c *GameController) drawList(r Rect, items []listItem, align Align, kind regionKind) {
+	if r.Height <= 0 {
+		return
+	}
+
+	start := listWindow(len(items), c.cursorPosition, r.Height)
+	textArea, marks := r.SplitRight(1)
+	if start == 0 && len(items) <= r.Height {
+		textArea = r
+	}
+	for row := 0; row < r.Height && start+row < len(items); row++ {
+		index := start + row
+		item := items[index]
+
+		// カーソル位置に応じてスタイルを変更（背景色のみで選択表示）
+		style := item.style
+		if index == c.cursorPosition {
+			style = SelectedStyle()
+		} else if style == nil {
+			style = DefaultStyle()
+		}
+		drawn := c.drawLabel(textArea.Row(row), align, style, item.text)
+		c.addRegion(drawn, kind, index)
+	}
+
+	if start > 0 {
+		c.drawLabel(marks.Row(0), AlignLeft, DisabledStyle(), "▲")
+	}
+	if start+r.Height < len(items) {
+		c.drawLabel(marks.Row(-1), AlignLeft, DisabledStyle(), "▼")
+	}
+}
+
+// drawPage は画面の見出しと操作説明を描画し、その間の本文の範囲を返すのじゃ
+// 見出しは上から4行目、操作説明は下から3行目の中央に置き、下の2行はエラーなどの知らせに空けておくのじゃ
+func (c *GameController) drawPage(area Rect, title, help string) Rect {
+	c.drawLabel(area.Row(3), AlignCenter, DefaultStyle(), title)
+	c.drawLabel(area.Row(-3), AlignCenter, DefaultStyle(), help)
+	_, body := area.SplitTop(5)
+	_, body = body.SplitBottom(4)
+	return body
+}
+
+// drawNotice はエラーなどの知らせを画面の下から2行目に描画するのじゃ
+func (c *GameController) drawNotice(area Rect, text string) {
+	c.drawLabel(area.Row(-2).Inset(1, 0), AlignLeft, DefaultStyle(), text)
+}
@@ -0,0 +1,7 @@
+package usecase
+
+// CombatLogRepository は戦闘ログを書き出すインターフェースを定義するのじゃ
+type CombatLogRepository interface {
+	// Export は戦闘ログの文章を書き出し、書き出した先の場所を返すのじゃ
+	Export(text string) (string, error)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// rollRewards は戦闘に勝ったときに受け取れる報酬を並べるのじゃ
+// ゴールドは必ず、ポーションは一定の確率で落とすのじゃ
+// エリートはレリックと、もう1つのカード報酬を落とすのじゃ
+func (i *GameInteractor) rollRewards(nodeType entities.NodeType) []entities.Reward {
+	rewards := []entities.Reward{
+		{Kind: entities.RewardGold, Gold: i.combatGold(i.RewardService.RollGold(nodeType))},
+	}
+	if i.PotionService.RollPotionDrop() {
+		rewards = append(rewards, entities.Reward{Kind: entities.RewardPotion, Potion: i.PotionService.GetRandomPotion()})
+	}
+	if nodeType == entities.NodeElite {
+		if relic, ok := i.RelicService.GetRandomRelic(i.Player); ok {
+			rewards = append(rewards, entities.Reward{Kind: entities.RewardRelic, Relic: relic})
+		}
+	}
+
+	cardRewards := 1
+	if nodeType == entities.NodeElite {
+		cardRewards++
+	}
+	for n := 0; n < cardRewards; n++ {
+		rewards = append(rewards, entities.Reward{Kind: entities.RewardCards, Cards: i.DeckService.GetRandomCardReward()})
+	}
+	return rewards
+}
+
+// ClaimReward は報酬を1つ受け取るのじゃ
+// カード報酬はSelectRewardCardで1枚選ぶので、ここでは受け取れないのじゃ
+// ポーションの所持枠が埋まっているときは受け取れず、報酬はそのまま残るのじゃ
+func (i *GameInteractor) ClaimReward(index int) bool {
+	if i.State != entities.StateReward || index < 0 || index >= len(i.Rewards) {
+		return false
+	}
+
+	reward := i.Rewards[index]
+	switch reward.Kind {
+	case entities.RewardGold:
+		i.Player.Gold += reward.Gold
+	case entities.RewardPotion:
+		if !i.Player.AddPotion(reward.Potion) {
+			return false
+		}
+	case entities.RewardRelic:
+		i.Player.AddRelic(reward.Relic)
+	default:
+		return false
+	}
+	i.removeReward(index)
+	return true
+}
+
+// SelectRewardCard はカード報酬から1枚を選んでデッキに加えるのじゃ
+func (i *GameInteractor) SelectRewardCard(index, cardIndex int) bool {
+	if i.State != entities.StateReward || index < 0 || index >= len(i.Rewards) {
+		return false
+	}
+	reward := i.Rewards[index]
+	if reward.Kind != entities.RewardCards || cardIndex < 0 || cardIndex >= len(reward.Cards) {
+		return false
+	}
+
+	i.Player.Deck = append(i.Player.Deck, reward.Cards[cardIndex])
+	i.removeReward(index)
+	return true
+}
+
+// SkipReward は報酬を受け取らずに捨てるのじゃ
+func (i *GameInteractor) SkipReward(index int) bool {
+	if i.State != entities.StateReward || index < 0 || index >= len(i.Rewards) {
+		return false
+	}
+	i.removeReward(index)
+	return true
+}
+
+// LeaveRewards は残っている報酬を受け取らずにマップに戻るのじゃ
+func (i *GameInteractor) LeaveRewards() {
+	i.Rewards = []entities.Reward{}
+	i.ReturnToMap()
+}
+
+// removeReward は受け取ったか捨てた報酬を取り除いて保存し直すのじゃ
+// 報酬が無くなったらマップに戻るのじゃ
+func (i *GameInteractor) removeReward(index int) {
+	i.Rewards = append(i.Rewards[:index:index], i.Rewards[index+1:]...)
+	if len(i.Rewards) == 0 {
+		i.ReturnToMap()
+		return
+	}
+	i.autosave(true)
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"io"
+	"sort"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// DailyInteractor はデイリーチャレンジのリーダーボードのユースケースを実装するのじゃ
+type DailyInteractor struct {
+	repository LeaderboardRepository
+	codec      LeaderboardCodec
+}
+
+// NewDailyInteractor はDailyInteractorのインスタンスを生成するのじゃ
+func NewDailyInteractor(repository LeaderboardRepository, codec LeaderboardCodec) *DailyInteractor {
+	return &DailyInteractor{repository: repository, codec: codec}
+}
+
+// GetLeaderboard は指定された日付の記録をスコアの高い順に返すのじゃ
+func (d *DailyInteractor) GetLeaderboard(date string) ([]entities.LeaderboardEntry, error) {
+	entries, err := d.findByDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].Score > entries[b].Score
+	})
+	return entries, nil
+}
+
+// Export は指定された日付の記録を書き出し、書き出した件数を返すのじゃ
+// 日付が空なら全ての記録を書き出すのじゃ
+func (d *DailyInteractor) Export(w io.Writer, date string) (int, error) {
+	entries, err := d.findByDate(date)
+	if err != nil {
+		return 0, err
+	}
+	if err := d.codec.Encode(w, entries); err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+// findByDate は指定された日付の記録を返すのじゃ
+// 日付が空なら全ての記録を返すのじゃ
+func (d *DailyInteractor) findByDate(date string) ([]entities.LeaderboardEntry, error) {
+	entries, err := d.repository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []entities.LeaderboardEntry{}
+	for _, entry := range entries {
+		if date == "" || entry.Date == date {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// Import は他の人が書き出した記録をリーダーボードに取り込み、追加した件数を返すのじゃ
+// すでにある記録は取り込まないのじゃ
+func (d *DailyInteractor) Import(r io.Reader) (int, error) {
+	entries, err := d.codec.Decode(r)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := d.repository.FindAll()
+	if err != nil {
+		return 0, err
+	}
+
+	added := []entities.LeaderboardEntry{}
+	for _, entry := range entries {
+		if !containsEntry(existing, entry) && !containsEntry(added, entry) {
+			added = append(added, entry)
+		}
+	}
+
+	if len(added) == 0 {
+		return 0, nil
+	}
+	if err := d.repository.Add(added...); err != nil {
+		return 0, err
+	}
+	return len(added), nil
+}
+
+// containsEntry は同じランの記録が含まれているかを判定するのじゃ
+func containsEntry(entries []entities.LeaderboardEntry, entry entities.LeaderboardEntry) bool {
+	for _, known := range entries {
+		if known.SameAs(entry) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,10 +1,13 @@
 package usecase
 
 import (
+	"errors"
 	"math/rand"
+	"time"
 
 	"github.com/yanosea/cts/internal/domain/entities"
 	"github.com/yanosea/cts/internal/domain/services"
+	"github.com/yanosea/cts/internal/i18n"
 )
 
 // GameInteractor はゲーム全体のユースケースを実装するのじゃ
@@ -12,41 +15,263 @@ type GameInteractor struct {
 	Player        *entities.Player
 	Enemy         *entities.Enemy
 	GameMap       *entities.GameMap
-	CardRewards   []entities.Card
+	Rewards       []entities.Reward // 直前の戦闘で受け取れる報酬のうち、まだ残っているものじゃ
 	State         entities.GameState
 	DeckService   *services.DeckService
 	CombatService *services.CombatService
+	ScoreService  *services.ScoreService
+	PotionService *services.PotionService
+	RelicService  *services.RelicService
+	ShopService   *services.ShopService
+	RewardService *services.RewardService
+	Shop          *entities.Shop
+	Ascension     entities.Ascension
+	Daily         *entities.DailyChallenge // デイリーチャレンジでなければnilじゃ
+	Stats         entities.RunStats
+	CombatLog     *entities.CombatLog // ラン中の戦闘で起きたことの記録じゃ
+	Score         entities.Score
 	Done          bool
+	Victory       bool
+	Seed          int64
+	Character     entities.Character
+	PlayerName    string
+	StartedAt     time.Time
+	HistoryError  error // ランの記録に失敗したときのエラーじゃ
+	ProfileError  error // プロフィールの保存に失敗したときのエラーじゃ
+	SaveError     error // ランの中断データの保存に失敗したときのエラーじゃ
+	// リーダーボードへの記録に失敗したときのエラーじゃ
+	LeaderboardError error
+	// 勝利によって次のアセンションが解放されたかどうかじゃ
+	AscensionUnlocked bool
+	// このランの経験値で新しく解放された段階じゃ
+	NewUnlocks   []entities.UnlockTier
+	Rng          *rand.Rand
+	rewardRng    *rand.Rand
+	shuffleRng   *rand.Rand
+	repositories Repositories
+	// ラン開始時のプロフィールじゃ。読み込めなかったときやデイリーチャレンジではnilじゃ
+	profile  *entities.Profile
+	recorded bool
+	// 戦闘開始時の体力じゃ。ダメージを受けずに勝ったかの判定に使うのじゃ
+	combatStartHealth int
+}
+
+// RunOptions はランの開始時に決める設定じゃ
+type RunOptions struct {
+	Seed       int64
+	Ascension  int
+	Character  string                   // 空ならキャラクター選択画面から始めるのじゃ
+	Daily      *entities.DailyChallenge // デイリーチャレンジのときだけ指定するのじゃ
+	PlayerName string                   // リーダーボードに載せる名前じゃ
+}
+
+// NewDailyRunOptions はその日のデイリーチャレンジのランの設定を返すのじゃ
+// シードはデイリーチャレンジのものを使い、アセンションは0、キャラクターは最初のキャラクターに固定するのじゃ
+func NewDailyRunOptions(daily entities.DailyChallenge, playerName string) RunOptions {
+	return RunOptions{
+		Seed:       daily.Seed,
+		Ascension:  0,
+		Character:  entities.DefaultCharacterID,
+		Daily:      &daily,
+		PlayerName: playerName,
+	}
 }
 
 // NewGameInteractor はGameInteractorのインスタンスを生成するのじゃ
-func NewGameInteractor() *GameInteractor {
-	deckService := services.NewDeckService()
+// 同じ乱数の種からは同じマップ、敵、報酬が生成されるのじゃ
+func NewGameInteractor(options RunOptions, repositories Repositories) *GameInteractor {
+	// 用途ごとに乱数生成器を分けて、プレイの仕方でマップや報酬が変わらないようにするのじゃ
+	mapRng := rand.New(rand.NewSource(options.Seed))
+	rewardRng := rand.New(rand.NewSource(options.Seed + 1))
+	shuffleRng := rand.New(rand.NewSource(options.Seed + 2))
+	enemyRng := rand.New(rand.NewSource(options.Seed + 3))
+
+	deckService := services.NewDeckService(rewardRng, shuffleRng)
 	combatService := services.NewCombatService(deckService)
-
-	player := entities.NewPlayer()
-	player.Deck = deckService.InitializeStarterDeck()
+	potionService := services.NewPotionService(rewardRng)
+	relicService := services.NewRelicService(rewardRng)
+	shopService := services.NewShopService(rewardRng, deckService, relicService, potionService)
+	ascension := entities.NewAscension(options.Ascension)
+
+	// 解放されていないカードやレリックは報酬や店に出さないのじゃ
+	// デイリーチャレンジは誰でも同じ内容になるよう、解放状況を使わないのじゃ
+	var profileErr error
+	var runProfile *entities.Profile
+	if options.Daily == nil && repositories.Profile != nil {
+		profile, err := repositories.Profile.Load()
+		if err != nil {
+			profileErr = err
+		} else {
+			runProfile = &profile
+			deckService.RestrictCardPool(func(card entities.Card) bool {
+				return profile.IsCardUnlocked(card.ID)
+			})
+			relicService.RestrictRelicPool(func(relic entities.Relic) bool {
+				return profile.IsRelicUnlocked(relic.ID)
+			})
+		}
+	}
 
 	// ゲームマップを生成するのじゃ
-	gameMap := entities.NewGameMap(15, 4) // 15フロア、各フロア4ノード
+	mapConfig := entities.DefaultMapConfig()
+	mapConfig.AddWeight(entities.NodeElite, 10*ascension.Count(entities.AscensionMoreElites))
 
-	return &GameInteractor{
-		Player:        player,
+	// デイリーチャレンジのマップの変化を反映するのじゃ
+	if daily := options.Daily; daily != nil {
+		if daily.Has(entities.DailyDoubleElites) {
+			mapConfig.AddWeight(entities.NodeElite, mapConfig.GetWeight(entities.NodeElite))
+		}
+		if daily.Has(entities.DailyNoRestSites) {
+			mapConfig.AddWeight(entities.NodeRest, -mapConfig.GetWeight(entities.NodeRest))
+		}
+	}
+
+	gameMap := entities.NewGameMap(mapRng, mapConfig)
+
+	interactor := &GameInteractor{
+		Player:        nil, // キャラクターを選ぶまでは決まらないのじゃ
 		Enemy:         nil,
 		GameMap:       gameMap,
-		CardRewards:   []entities.Card{},
-		State:         entities.StateMap, // マップ画面から開始
+		Rewards:       []entities.Reward{},
+		State:         entities.StateCharacterSelect, // キャラクター選択画面から開始
 		DeckService:   deckService,
 		CombatService: combatService,
+		ScoreService:  services.NewScoreService(),
+		PotionService: potionService,
+		RelicService:  relicService,
+		ShopService:   shopService,
+		RewardService: services.NewRewardService(rewardRng),
+		ProfileError:  profileErr,
+		Ascension:     ascension,
+		Daily:         options.Daily,
+		PlayerName:    options.PlayerName,
 		Done:          false,
+		Victory:       false,
+		Seed:          options.Seed,
+		CombatLog:     entities.NewCombatLog(),
+		StartedAt:     time.Now(),
+		Rng:           enemyRng,
+		rewardRng:     rewardRng,
+		shuffleRng:    shuffleRng,
+		repositories:  repositories,
+		profile:       runProfile,
+		recorded:      false,
+	}
+
+	// キャラクターが指定されていればすぐにランを始めるのじゃ
+	if options.Character != "" {
+		interactor.SelectCharacter(options.Character)
+	}
+
+	return interactor
+}
+
+// IsCharacterUnlocked はキャラクターがこのランで選べるかを判定するのじゃ
+// デイリーチャレンジでは解放状況を使わないのじゃ
+func (i *GameInteractor) IsCharacterUnlocked(id string) bool {
+	if i.Daily != nil {
+		return true
+	}
+	if i.profile == nil {
+		return id == entities.DefaultCharacterID
 	}
+	return i.profile.IsCharacterUnlocked(id)
+}
+
+// SelectCharacter はキャラクターを決めてプレイヤーを用意し、最初の戦闘を始めるのじゃ
+func (i *GameInteractor) SelectCharacter(id string) bool {
+	character, ok := entities.GetCharacterByID(id)
+	if !ok || i.State != entities.StateCharacterSelect || !i.IsCharacterUnlocked(id) {
+		return false
+	}
+	i.setCharacter(character)
+
+	player := entities.NewPlayer(character)
+	player.Deck = i.DeckService.InitializeStarterDeck(character)
+	if relic, ok := entities.CreateRelicByID(character.StarterRelic); ok {
+		player.AddRelic(relic)
+	}
+	applyAscensionToPlayer(player, i.Ascension)
+
+	// デイリーチャレンジのプレイヤーへの変化を反映するのじゃ
+	if daily := i.Daily; daily != nil {
+		if daily.Has(entities.DailyRandomRare) {
+			player.Deck = append(player.Deck, i.DeckService.GetRandomRareCard())
+		}
+		if daily.Has(entities.DailyExpensiveCards) {
+			player.CostModifier++
+		}
+	}
+
+	player.Log = i.CombatLog
+	i.Player = player
+	i.StartedAt = time.Now()
+
+	// 最初の戦闘を開始するのじゃ
+	i.enterCurrentNode()
+	return true
+}
+
+// setCharacter は操作するキャラクターを決めるのじゃ
+func (i *GameInteractor) setCharacter(character entities.Character) {
+	i.Character = character
+
+	// キャラクターの色のカードと無色のカードだけを報酬や店に出すのじゃ
+	i.DeckService.RestrictCardPool(character.CanUseCard)
+}
+
+// applyAscensionToPlayer はアセンションによる変化を開始時のプレイヤーに反映するのじゃ
+func applyAscensionToPlayer(player *entities.Player, ascension entities.Ascension) {
+	player.MaxHealth -= 4 * ascension.Count(entities.AscensionLowerMaxHealth)
+	player.Health = player.MaxHealth
+	if ascension.Count(entities.AscensionInjuredStart) > 0 {
+		player.Health = player.MaxHealth * 9 / 10
+	}
+
+	for n := 0; n < ascension.Count(entities.AscensionStartingCurse); n++ {
+		player.Deck = append(player.Deck, entities.CreateInjuryCard())
+	}
+
+	player.PotionSlots = max(0, player.PotionSlots-ascension.Count(entities.AscensionFewerPotionSlots))
+}
+
+// applyAscensionToEnemy はアセンションによる強化を敵に反映するのじゃ
+func (i *GameInteractor) applyAscensionToEnemy(nodeType entities.NodeType) {
+	strength, tougher := 0, 0
+	switch nodeType {
+	case entities.NodeEnemy:
+		strength = i.Ascension.Count(entities.AscensionStrongerEnemies)
+		tougher = i.Ascension.Count(entities.AscensionTougherEnemies)
+	case entities.NodeElite:
+		strength = i.Ascension.Count(entities.AscensionStrongerElites)
+		tougher = i.Ascension.Count(entities.AscensionTougherElites)
+	case entities.NodeBoss:
+		strength = 2 * i.Ascension.Count(entities.AscensionStrongerBoss)
+		tougher = i.Ascension.Count(entities.AscensionTougherBoss)
+	}
+
+	i.Enemy.AddStrength(strength)
+	i.Enemy.MaxHealth = i.Enemy.MaxHealth * (100 + 10*tougher) / 100
+	i.Enemy.Health = i.Enemy.MaxHealth
+}
+
+// RestHealPercent は休憩所で回復する体力の割合（%）を返すのじゃ
+func (i *GameInteractor) RestHealPercent() int {
+	return max(0, 30-5*i.Ascension.Count(entities.AscensionLessHealing))
+}
+
+// combatGold は戦闘で得られるゴールドにアセンションの補正をかけるのじゃ
+func (i *GameInteractor) combatGold(base int) int {
+	return base * max(0, 100-20*i.Ascension.Count(entities.AscensionLessGold)) / 100
 }
 
 // StartNewCombat は新しい戦闘を開始するのじゃ
 func (i *GameInteractor) StartNewCombat() {
-	// デッキを山札にセットするのじゃ
-	i.Player.DrawPile = i.Player.Deck
+	// デッキの写しを山札にセットするのじゃ
+	// 山札をシャッフルしてもデッキの並びが変わらないようにするのじゃ
+	i.Player.DrawPile = append([]entities.Card{}, i.Player.Deck...)
 	i.Player.DiscardPile = []entities.Card{}
+	i.Player.ExhaustPile = []entities.Card{}
 	i.Player.Hand = []entities.Card{}
 
 	// デッキをシャッフルするのじゃ
@@ -55,7 +280,7 @@ func (i *GameInteractor) StartNewCombat() {
 	// 現在のマップノードタイプに基づいて敵を生成するのじゃ
 	if i.GameMap.CurrentNode.Type == entities.NodeEnemy {
 		// ランダムに通常の敵を選択するのじゃ
-		if rand.Intn(2) == 0 {
+		if i.Rng.Intn(2) == 0 {
 			i.Enemy = entities.NewSlimeEnemy()
 		} else {
 			i.Enemy = entities.NewJawWormEnemy()
@@ -74,18 +299,34 @@ func (i *GameInteractor) StartNewCombat() {
 		i.Enemy.MaxHealth *= 3
 		i.Enemy.Health = i.Enemy.MaxHealth
 		i.Enemy.AddStrength(5)
-		i.Enemy.Name = "超アゴムシ"
+		i.Enemy.Name = i18n.Msg("enemy.boss", i.Enemy.Name)
 	}
+	i.applyAscensionToEnemy(i.GameMap.CurrentNode.Type)
+
+	// ここからの出来事を戦闘ログに記録するのじゃ
+	i.Enemy.Log = i.CombatLog
+	i.CombatLog.StartCombat(i.GameMap.CurrentNode.Position.Floor, i.Enemy.Name)
+	i.CombatLog.StartTurn()
+
+	// 戦闘開始時の体力を覚えておくのじゃ
+	i.combatStartHealth = i.Player.Health
 
 	// バフ、デバフをリセットするのじゃ
+	// パワーはその戦闘の間だけ続くので、前の戦闘のものは取り除くのじゃ
 	i.Player.Vulnerable = 0
 	i.Player.Weak = 0
+	i.Player.Powers = []*entities.Power{}
 
 	// プレイヤーのエナジーをリセットするのじゃ
 	i.Player.ResetEnergy()
 
+	// 戦闘開始時のレリック効果を実行するのじゃ
+	i.Player.ExecuteCombatStartRelics(i.Enemy)
+
 	// 初期手札を引くのじゃ
-	i.CombatService.DrawCards(i.Player, 5)
+	// レリックで追加のドローが指定されていればまとめて引くのじゃ
+	i.CombatService.DrawCards(i.Player, 5+i.Player.DrawCount)
+	i.Player.DrawCount = 0
 
 	// パワー効果を実行するのじゃ
 	i.Player.ExecuteStartTurnPowers(i.Enemy)
@@ -97,25 +338,44 @@ func (i *GameInteractor) StartNewCombat() {
 // SelectMapNode はマップ上のノードを選択するのじゃ
 func (i *GameInteractor) SelectMapNode(node *entities.MapNode) bool {
 	if i.GameMap.MoveToNode(node) {
-		// ノードの種類に応じた状態に移行するのじゃ
-		switch node.Type {
-		case entities.NodeEnemy, entities.NodeElite, entities.NodeBoss:
-			i.StartNewCombat()
-		case entities.NodeRest:
-			i.State = entities.StateRest
-		case entities.NodeShop:
-			i.State = entities.StateShop
-		case entities.NodeEvent, entities.NodeTreasure:
-			i.State = entities.StateEvent
-		}
+		i.Stats.FloorsClimbed++
+		i.enterCurrentNode()
 		return true
 	}
 	return false
 }
 
+// enterCurrentNode は現在のノードに入り、ノードの種類に応じた状態に移行するのじゃ
+// 中断しても同じ展開から再開できるよう、入る前に乱数を作り直して保存しておくのじゃ
+func (i *GameInteractor) enterCurrentNode() {
+	node := i.GameMap.CurrentNode
+	i.reseedForFloor(node.Position.Floor)
+	i.autosave(true)
+
+	switch node.Type {
+	case entities.NodeEnemy, entities.NodeElite, entities.NodeBoss:
+		i.StartNewCombat()
+	case entities.NodeRest:
+		i.State = entities.StateRest
+	case entities.NodeShop:
+		i.Shop = i.ShopService.GenerateShop(i.Player)
+		i.State = entities.StateShop
+	case entities.NodeEvent, entities.NodeTreasure:
+		i.State = entities.StateEvent
+	}
+}
+
+// reseedForFloor はフロアごとに決まる種で報酬、シャッフル、敵の乱数を作り直すのじゃ
+func (i *GameInteractor) reseedForFloor(floor int) {
+	base := i.Seed + int64(floor+1)*1000
+	i.rewardRng.Seed(base + 1)
+	i.shuffleRng.Seed(base + 2)
+	i.Rng.Seed(base + 3)
+}
+
 // RestHeal は休憩所で回復するのじゃ
 func (i *GameInteractor) RestHeal() {
-	healAmount := i.Player.MaxHealth / 3
+	healAmount := i.Player.MaxHealth * i.RestHealPercent() / 100
 	i.Player.Health = min(i.Player.MaxHealth, i.Player.Health+healAmount)
 	i.ReturnToMap()
 }
@@ -129,6 +389,7 @@ func (i *GameInteractor) RestUpgrade() {
 // ReturnToMap はマップ画面に戻るのじゃ
 func (i *GameInteractor) ReturnToMap() {
 	i.State = entities.StateMap
+	i.autosave(false)
 }
 
 // UseCard はカードを使用するのじゃ
@@ -141,28 +402,116 @@ func (i *GameInteractor) UseCard(cardIndex int) bool {
 		i.Player.DrawCount = 0
 	}
 
-	// 敵の体力が0以下なら報酬画面へ移るのじゃ
+	// 敵の体力が0以下なら勝利じゃ
 	if success && i.Enemy.IsDefeated() {
-		i.State = entities.StateReward
-
-		// 敵の種類によって報酬を変えるのじゃ
-		if i.GameMap.CurrentNode.Type == entities.NodeEnemy {
-			i.Player.Gold += 10
-		} else if i.GameMap.CurrentNode.Type == entities.NodeElite {
-			i.Player.Gold += 25
-		} else if i.GameMap.CurrentNode.Type == entities.NodeBoss {
-			i.Player.Gold += 50
+		i.winCombat()
+	}
+
+	return success
+}
+
+// UsePotion は戦闘中にポーションを使用するのじゃ
+func (i *GameInteractor) UsePotion(potionIndex int) bool {
+	if i.State != entities.StateCombat || potionIndex < 0 || potionIndex >= len(i.Player.Potions) {
+		return false
+	}
+
+	potion := i.Player.Potions[potionIndex]
+	i.CombatLog.Add(entities.LogPotion, "log.potion", potion.Name)
+	potion.Effect(i.Player, i.Enemy)
+	i.Player.RemovePotion(potionIndex)
+
+	if i.Enemy.IsDefeated() {
+		i.winCombat()
+	}
+
+	return true
+}
+
+// winCombat は敵を倒したときの処理をするのじゃ
+func (i *GameInteractor) winCombat() {
+	i.CombatLog.Add(entities.LogCombat, "log.enemy_defeated", i.Enemy.Name)
+	i.recordCombatVictory()
+
+	// 戦闘終了時のレリック効果を実行するのじゃ
+	i.Player.ExecuteCombatEndRelics(i.Enemy)
+
+	// ボスを倒したらランの勝利じゃ
+	if i.GameMap.CurrentNode.Type == entities.NodeBoss {
+		i.Player.Gold += i.combatGold(i.RewardService.RollGold(entities.NodeBoss))
+		i.Victory = true
+		i.State = entities.StateGameOver
+		i.finishRun(entities.ResultVictory)
+		return
+	}
+
+	// 敵の種類に応じた報酬を並べて、報酬画面へ移るのじゃ
+	// 再開したときに同じ戦闘をやり直さないよう、報酬の画面として保存し直すのじゃ
+	i.Rewards = i.rollRewards(i.GameMap.CurrentNode.Type)
+	i.State = entities.StateReward
+	i.autosave(true)
+}
+
+// BuyShopItem は店の商品を買うのじゃ
+// ゴールドが足りない、売り切れ、ポーションの所持枠が埋まっているときは買えないのじゃ
+func (i *GameInteractor) BuyShopItem(itemIndex int) bool {
+	if i.State != entities.StateShop || i.Shop == nil || itemIndex < 0 || itemIndex >= len(i.Shop.Items) {
+		return false
+	}
+
+	item := &i.Shop.Items[itemIndex]
+	if item.Sold || i.Player.Gold < item.Price {
+		return false
+	}
+
+	switch item.Kind {
+	case entities.ShopCard:
+		i.Player.Deck = append(i.Player.Deck, item.Card)
+	case entities.ShopRelic:
+		i.Player.AddRelic(item.Relic)
+	case entities.ShopPotion:
+		if !i.Player.AddPotion(item.Potion) {
+			return false
 		}
+	}
 
-		// カード報酬を生成するのじゃ
-		i.CardRewards = i.DeckService.GetRandomCardReward()
+	i.Player.Gold -= item.Price
+	item.Sold = true
+	i.autosave(true)
+	return true
+}
+
+// recordCombatVictory は戦闘の勝利を戦績に記録するのじゃ
+func (i *GameInteractor) recordCombatVictory() {
+	switch i.GameMap.CurrentNode.Type {
+	case entities.NodeEnemy:
+		i.Stats.EnemiesKilled++
+	case entities.NodeElite:
+		i.Stats.ElitesKilled++
+	case entities.NodeBoss:
+		i.Stats.BossesKilled++
 	}
 
-	return success
+	if i.Player.Health >= i.combatStartHealth {
+		i.Stats.PerfectFights++
+	}
 }
 
-// EndTurn はターンを終了するのじゃ
+// EndTurn はターンを終了し、敵の行動の後に次のターンを始めるのじゃ
+// 段階ごとに見せたいときは、EndPlayerTurn、PerformEnemyTurn、StartPlayerTurnを順に呼ぶのじゃ
 func (i *GameInteractor) EndTurn() {
+	i.EndPlayerTurn()
+	i.PerformEnemyTurn()
+	i.StartPlayerTurn()
+}
+
+// EndPlayerTurn はプレイヤーのターンを終えるのじゃ
+// 手札を捨て、ターン終了時の効果を実行して、状態の効果時間を減らすのじゃ
+func (i *GameInteractor) EndPlayerTurn() {
+	if i.State != entities.StateCombat {
+		return
+	}
+
 	// 手札を捨て札に移すのじゃ
 	i.Player.DiscardPile = append(i.Player.DiscardPile, i.Player.Hand...)
 	i.Player.Hand = []entities.Card{}
@@ -183,37 +532,173 @@ func (i *GameInteractor) EndTurn() {
 	if i.Enemy.Weak > 0 {
 		i.Enemy.Weak--
 	}
+}
+
+// PerformEnemyTurn は敵のアクションを実行するのじゃ
+// プレイヤーの体力が0以下になったらゲームオーバーじゃ
+func (i *GameInteractor) PerformEnemyTurn() {
+	if i.State != entities.StateCombat {
+		return
+	}
 
-	// 敵のアクションを実行するのじゃ
 	i.Enemy.PerformAction(i.Player)
 
-	// プレイヤーの体力が0以下ならゲームオーバー
 	if i.Player.IsDefeated() {
+		i.CombatLog.Add(entities.LogCombat, "log.player_defeated", i.Enemy.Name)
 		i.State = entities.StateGameOver
-	} else {
-		// 新しいターンの準備をするのじゃ
-		i.Player.ResetEnergy()
-		i.CombatService.DrawCards(i.Player, 5)
-		i.Player.ExecuteStartTurnPowers(i.Enemy)
+		i.finishRun(entities.ResultDefeat)
 	}
 }
 
-// SelectCardReward は報酬からカードを選択するのじゃ
-func (i *GameInteractor) SelectCardReward(cardIndex int) bool {
-	if cardIndex >= 0 && cardIndex < len(i.CardRewards) {
-		// 選択したカードをデッキに追加するのじゃ
-		i.Player.Deck = append(i.Player.Deck, i.CardRewards[cardIndex])
-		i.CardRewards = []entities.Card{}
-		i.ReturnToMap()
-		return true
+// StartPlayerTurn は新しいターンの準備をするのじゃ
+// エナジーを戻してカードを引き、ターン開始時の効果を実行するのじゃ
+func (i *GameInteractor) StartPlayerTurn() {
+	if i.State != entities.StateCombat {
+		return
 	}
-	return false
+
+	i.CombatLog.StartTurn()
+	i.Player.ResetEnergy()
+	i.CombatService.DrawCards(i.Player, 5)
+	i.Player.ExecuteStartTurnPowers(i.Enemy)
 }
 
-// SkipCardReward はカード報酬をスキップするのじゃ
-func (i *GameInteractor) SkipCardReward() {
-	i.CardRewards = []entities.Card{}
-	i.ReturnToMap()
+// ExportCombatLog はこのランの戦闘ログを書き出し、書き出した先の場所を返すのじゃ
+func (i *GameInteractor) ExportCombatLog() (string, error) {
+	if i.repositories.CombatLog == nil {
+		return "", errors.New(i18n.T("error.no_combat_log_store"))
+	}
+
+	header := i18n.T("log.export_header", i.Seed, i.Character.Name, i.Ascension.Level)
+	return i.repositories.CombatLog.Export(header + i.CombatLog.String())
+}
+
+// SuspendRun はランを中断して今の状態を保存し、ゲームを終了するのじゃ
+// 戦闘の途中は残せないので、部屋に入ったときの保存を残して戦闘の始めからやり直すのじゃ
+// 保存先が無ければ再開できないので、放棄したものとして記録するのじゃ
+func (i *GameInteractor) SuspendRun() {
+	if i.repositories.Save == nil {
+		i.AbandonRun()
+		return
+	}
+	if i.Player != nil && i.State != entities.StateCombat {
+		i.autosave(i.State != entities.StateMap)
+	}
+	i.SetDone(true)
+}
+
+// AbandonRun は途中のランを放棄して履歴に記録し、ゲームを終了するのじゃ
+func (i *GameInteractor) AbandonRun() {
+	// キャラクターを選ぶ前ならランは始まっておらんので記録しないのじゃ
+	if i.Player != nil {
+		i.finishRun(entities.ResultAbandoned)
+	}
+	i.SetDone(true)
+}
+
+// finishRun はランの結果を履歴に記録するのじゃ
+// 1つのランにつき一度だけ記録するのじゃ
+func (i *GameInteractor) finishRun(result entities.RunResult) {
+	if i.recorded {
+		return
+	}
+	i.recorded = true
+
+	// 終わったランは再開できないのじゃ
+	if i.repositories.Save != nil {
+		if err := i.repositories.Save.Delete(); err != nil {
+			i.SaveError = err
+		}
+	}
+
+	// 最終的なスコアを計算するのじゃ
+	i.Score = i.ScoreService.Calculate(i.Player, i.Stats)
+
+	record := entities.RunRecord{
+		Seed:       i.Seed,
+		Character:  i.Character.ID,
+		Ascension:  i.Ascension.Level,
+		Floor:      i.GameMap.CurrentNode.Position.Floor,
+		Result:     result,
+		Deck:       []string{},
+		Relics:     []string{},
+		Gold:       i.Player.Gold,
+		Score:      i.Score.Total,
+		ScoreItems: i.Score.Items,
+		Duration:   time.Since(i.StartedAt),
+		Timestamp:  time.Now(),
+	}
+	if result == entities.ResultDefeat && i.Enemy != nil {
+		record.KilledBy = i.Enemy.Name.String()
+	}
+	for _, card := range i.Player.Deck {
+		record.Deck = append(record.Deck, card.Name.String())
+	}
+	for _, relic := range i.Player.Relics {
+		record.Relics = append(record.Relics, relic.Name.String())
+	}
+
+	if i.Daily != nil {
+		record.Daily = i.Daily.Date
+	}
+
+	if i.repositories.History != nil {
+		if _, err := i.repositories.History.Append(record); err != nil {
+			i.HistoryError = err
+		}
+	}
+
+	if i.Daily != nil {
+		i.addLeaderboardEntry(record)
+	}
+
+	i.updateProfile(result)
+}
+
+// addLeaderboardEntry はデイリーチャレンジの結果をリーダーボードに記録するのじゃ
+func (i *GameInteractor) addLeaderboardEntry(record entities.RunRecord) {
+	if i.repositories.Leaderboard == nil {
+		return
+	}
+
+	entry := entities.LeaderboardEntry{
+		Date:      i.Daily.Date,
+		Player:    i.PlayerName,
+		Character: record.Character,
+		Score:     record.Score,
+		Floor:     record.Floor,
+		Result:    record.Result,
+		Timestamp: record.Timestamp,
+	}
+	if err := i.repositories.Leaderboard.Add(entry); err != nil {
+		i.LeaderboardError = err
+	}
+}
+
+// updateProfile はランの結果をプロフィールに反映するのじゃ
+// スコアを経験値として加え、勝利したら次のアセンションを解放するのじゃ
+func (i *GameInteractor) updateProfile(result entities.RunResult) {
+	// デイリーチャレンジの結果はプロフィールに反映しないのじゃ
+	if i.repositories.Profile == nil || i.Daily != nil {
+		return
+	}
+
+	profile, err := i.repositories.Profile.Load()
+	if err != nil {
+		i.ProfileError = err
+		return
+	}
+
+	i.NewUnlocks = profile.AddExperience(i.Score.Total)
+	if result == entities.ResultVictory {
+		i.AscensionUnlocked = profile.UnlockNextAscension(i.Ascension.Level)
+	}
+
+	if err := i.repositories.Profile.Save(profile); err != nil {
+		i.ProfileError = err
+		i.AscensionUnlocked = false
+		i.NewUnlocks = nil
+	}
 }
 
 // SetDone はゲーム終了フラグを設定するのじゃ
@@ -233,3 +718,10 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
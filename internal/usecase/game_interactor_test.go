@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/usecase"
+)
+
+// dailyDates は同じ日のデイリーチャレンジを見比べる日付じゃ
+var dailyDates = []string{"2026-01-01", "2026-10-19", "2027-02-28"}
+
+// TestDailyRunsOnSameDateMatch は同じ日のデイリーチャレンジを同じように遊べば、同じ展開になることを確かめるのじゃ
+// マップ、変化、最初の戦闘の敵、報酬が一致するかを見るのじゃ
+func TestDailyRunsOnSameDateMatch(t *testing.T) {
+	for _, date := range dailyDates {
+		t.Run(date, func(t *testing.T) {
+			first := playDailyFirstCombat(t, date)
+			second := playDailyFirstCombat(t, date)
+
+			if !slices.Equal(first.Daily.Modifiers, second.Daily.Modifiers) {
+				t.Errorf("変化が違うのじゃ: %v と %v", first.Daily.Modifiers, second.Daily.Modifiers)
+			}
+			for _, c := range []struct {
+				name          string
+				first, second string
+			}{
+				{"マップ", describeMap(first.GameMap), describeMap(second.GameMap)},
+				{"最初の戦闘の敵", first.firstEnemy, second.firstEnemy},
+				{"デッキ", describeCards(first.Player.Deck), describeCards(second.Player.Deck)},
+				{"報酬", describeRewards(first.Rewards), describeRewards(second.Rewards)},
+			} {
+				if c.first != c.second {
+					t.Errorf("%sが違うのじゃ\n1回目: %s\n2回目: %s", c.name, c.first, c.second)
+				}
+			}
+		})
+	}
+}
+
+// dailyRun は最初の戦闘を終えたデイリーチャレンジのランじゃ
+type dailyRun struct {
+	*usecase.GameInteractor
+	firstEnemy string // 戦闘を始めたときの敵の名前と体力じゃ
+}
+
+// playDailyFirstCombat はその日のデイリーチャレンジを始め、最初の戦闘を決まった手順で勝つまで遊ぶのじゃ
+// 使えるカードのうち一番左のものを使い、使えるカードが無ければターンを終えるのじゃ
+func playDailyFirstCombat(t *testing.T, date string) dailyRun {
+	t.Helper()
+	daily, err := entities.ParseDailyChallenge(date)
+	if err != nil {
+		t.Fatalf("日付を読めなかったのじゃ: %v", err)
+	}
+	run := usecase.NewGameInteractor(usecase.NewDailyRunOptions(daily, "daily"), usecase.Repositories{})
+	if run.State != entities.StateCombat {
+		t.Fatalf("最初の戦闘が始まっておらんのじゃ: %v", run.State)
+	}
+	firstEnemy := fmt.Sprintf("%s %d/%d", run.Enemy.Name, run.Enemy.Health, run.Enemy.MaxHealth)
+
+	for step := 0; run.State == entities.StateCombat; step++ {
+		if step > 500 {
+			t.Fatal("最初の戦闘が終わらないのじゃ")
+		}
+		played := false
+		for index := range run.Player.Hand {
+			if run.UseCard(index) {
+				played = true
+				break
+			}
+		}
+		if !played {
+			run.EndTurn()
+		}
+	}
+	if run.State != entities.StateReward {
+		t.Fatalf("最初の戦闘に勝てなかったのじゃ: %v", run.State)
+	}
+	return dailyRun{GameInteractor: run, firstEnemy: firstEnemy}
+}
+
+// describeMap はマップのノードの種類、位置、つながりを文字列にするのじゃ
+func describeMap(gameMap *entities.GameMap) string {
+	var b strings.Builder
+	for _, floor := range gameMap.Nodes {
+		for _, node := range floor {
+			fmt.Fprintf(&b, "%d:%d:%d->", node.Position.Floor, node.Position.X, node.Type)
+			for _, next := range node.Connections {
+				fmt.Fprintf(&b, "%d,", next.Position.X)
+			}
+			b.WriteString(" ")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// describeCards はカードのIDを並べるのじゃ
+func describeCards(cards []entities.Card) string {
+	ids := make([]string, 0, len(cards))
+	for _, card := range cards {
+		ids = append(ids, card.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+// describeRewards は報酬の種類と中身を並べるのじゃ
+func describeRewards(rewards []entities.Reward) string {
+	parts := make([]string, 0, len(rewards))
+	for _, reward := range rewards {
+		switch reward.Kind {
+		case entities.RewardGold:
+			parts = append(parts, fmt.Sprintf("gold:%d", reward.Gold))
+		case entities.RewardPotion:
+			parts = append(parts, "potion:"+reward.Potion.ID)
+		case entities.RewardRelic:
+			parts = append(parts, "relic:"+reward.Relic.ID)
+		case entities.RewardCards:
+			parts = append(parts, "cards:"+describeCards(reward.Cards))
+		}
+	}
+	return strings.Join(parts, " ")
+}
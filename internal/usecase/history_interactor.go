@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// HistoryFilter はラン履歴を絞り込む条件じゃ
+// ゼロ値の項目は条件に使わないのじゃ
+type HistoryFilter struct {
+	Character string // キャラクターのIDじゃ
+	Result    *entities.RunResult
+	MinFloor  int
+	Seed      *int64
+	Limit     int  // 何件まで返すかじゃ
+	ByScore   bool // 新しい順ではなくスコアの高い順に並べるかどうかじゃ
+}
+
+// HistoryInteractor はラン履歴の閲覧のユースケースを実装するのじゃ
+type HistoryInteractor struct {
+	repository HistoryRepository
+}
+
+// NewHistoryInteractor はHistoryInteractorのインスタンスを生成するのじゃ
+func NewHistoryInteractor(repository HistoryRepository) *HistoryInteractor {
+	return &HistoryInteractor{repository: repository}
+}
+
+// ListRuns は条件に合うランを新しい順、またはスコアの高い順に返すのじゃ
+func (h *HistoryInteractor) ListRuns(filter HistoryFilter) ([]entities.RunRecord, error) {
+	records, err := h.repository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []entities.RunRecord{}
+	for idx := len(records) - 1; idx >= 0; idx-- {
+		record := records[idx]
+		if filter.Character != "" && record.Character != filter.Character {
+			continue
+		}
+		if filter.Result != nil && record.Result != *filter.Result {
+			continue
+		}
+		if record.Floor < filter.MinFloor {
+			continue
+		}
+		if filter.Seed != nil && record.Seed != *filter.Seed {
+			continue
+		}
+		result = append(result, record)
+	}
+
+	if filter.ByScore {
+		sort.SliceStable(result, func(a, b int) bool {
+			return result[a].Score > result[b].Score
+		})
+	}
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+// GetRun は指定されたIDのランを返すのじゃ
+func (h *HistoryInteractor) GetRun(id int) (entities.RunRecord, error) {
+	records, err := h.repository.FindAll()
+	if err != nil {
+		return entities.RunRecord{}, err
+	}
+
+	for _, record := range records {
+		if record.ID == id {
+			return record, nil
+		}
+	}
+
+	return entities.RunRecord{}, errors.New(i18n.T("error.run_not_found", id))
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// HistoryRepository はランの履歴を永続化するインターフェースを定義するのじゃ
+type HistoryRepository interface {
+	// Append はランの記録を履歴の末尾に追加し、採番したIDを返すのじゃ
+	Append(record entities.RunRecord) (int, error)
+	// FindAll は記録された全てのランを古い順に返すのじゃ
+	FindAll() ([]entities.RunRecord, error)
+}
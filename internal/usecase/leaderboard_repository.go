@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"io"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// LeaderboardRepository はデイリーチャレンジのリーダーボードを永続化するインターフェースを定義するのじゃ
+type LeaderboardRepository interface {
+	// Add はリーダーボードに記録を追加するのじゃ
+	Add(entries ...entities.LeaderboardEntry) error
+	// FindAll は全ての日付の記録を返すのじゃ
+	FindAll() ([]entities.LeaderboardEntry, error)
+}
+
+// LeaderboardCodec はリーダーボードを他の人と受け渡す形式を定義するのじゃ
+type LeaderboardCodec interface {
+	Encode(w io.Writer, entries []entities.LeaderboardEntry) error
+	Decode(r io.Reader) ([]entities.LeaderboardEntry, error)
+}
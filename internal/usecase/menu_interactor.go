@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// MenuInteractor はメニューからランを始めたり再開したりするユースケースを実装するのじゃ
+type MenuInteractor struct {
+	Character    string // 新しいランで使うキャラクターのIDじゃ。空ならラン開始時に選ぶのじゃ
+	Ascension    int    // 新しいランのアセンションじゃ
+	PlayerName   string // リーダーボードに載せる名前じゃ
+	repositories Repositories
+}
+
+// NewMenuInteractor はMenuInteractorのインスタンスを生成するのじゃ
+func NewMenuInteractor(repositories Repositories, playerName string) *MenuInteractor {
+	return &MenuInteractor{
+		Character:    "",
+		Ascension:    0,
+		PlayerName:   playerName,
+		repositories: repositories,
+	}
+}
+
+// SetCharacter は新しいランで使うキャラクターを決めるのじゃ
+// 空ならラン開始時に選ぶのじゃ
+func (m *MenuInteractor) SetCharacter(id string) error {
+	if id != "" {
+		if _, ok := entities.GetCharacterByID(id); !ok {
+			return errors.New(i18n.T("error.unknown_character", id))
+		}
+		profile, err := m.loadProfile()
+		if err != nil {
+			return err
+		}
+		if !profile.IsCharacterUnlocked(id) {
+			return errors.New(i18n.T("error.character_locked", id))
+		}
+	}
+	m.Character = id
+	return nil
+}
+
+// SetAscension は新しいランのアセンションを決めるのじゃ
+// 解放されたアセンションしか選べないのじゃ
+func (m *MenuInteractor) SetAscension(level int) error {
+	profile, err := m.loadProfile()
+	if err != nil {
+		return err
+	}
+	if level < 0 || level > profile.MaxAscension {
+		return errors.New(i18n.T("error.ascension_locked", level, profile.MaxAscension))
+	}
+	m.Ascension = level
+	return nil
+}
+
+// loadProfile はプロフィールを読み込むのじゃ。保存先が無ければ新しいプロフィールを返すのじゃ
+func (m *MenuInteractor) loadProfile() (entities.Profile, error) {
+	if m.repositories.Profile == nil {
+		return entities.NewProfile(), nil
+	}
+	return m.repositories.Profile.Load()
+}
+
+// HasSavedRun は再開できる中断したランがあるかを判定するのじゃ
+func (m *MenuInteractor) HasSavedRun() bool {
+	if m.repositories.Save == nil {
+		return false
+	}
+	saved, err := m.repositories.Save.Load()
+	return err == nil && saved != nil
+}
+
+// NewRun は新しいランを始めるのじゃ
+// 中断したランが残っていれば放棄したものとして記録するのじゃ
+func (m *MenuInteractor) NewRun() (*GameInteractor, error) {
+	if err := m.discardSavedRun(); err != nil {
+		return nil, err
+	}
+
+	return NewGameInteractor(RunOptions{
+		Seed:       time.Now().UnixNano(),
+		Ascension:  m.Ascension,
+		Character:  m.Character,
+		PlayerName: m.PlayerName,
+	}, m.repositories), nil
+}
+
+// NewDailyRun はデイリーチャレンジのランを始めるのじゃ
+// 中断したランが残っていれば放棄したものとして記録するのじゃ
+func (m *MenuInteractor) NewDailyRun(daily entities.DailyChallenge) (*GameInteractor, error) {
+	if err := m.discardSavedRun(); err != nil {
+		return nil, err
+	}
+
+	return NewGameInteractor(NewDailyRunOptions(daily, m.PlayerName), m.repositories), nil
+}
+
+// ContinueRun は中断したランを再開するのじゃ
+func (m *MenuInteractor) ContinueRun() (*GameInteractor, error) {
+	if m.repositories.Save == nil {
+		return nil, errors.New(i18n.T("error.no_saved_run"))
+	}
+	saved, err := m.repositories.Save.Load()
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil {
+		return nil, errors.New(i18n.T("error.no_saved_run"))
+	}
+
+	return ResumeGameInteractor(*saved, m.repositories)
+}
+
+// discardSavedRun は中断したランを放棄したものとして記録し、中断データを消すのじゃ
+// 壊れていて再開できない中断データはそのまま消すのじゃ
+func (m *MenuInteractor) discardSavedRun() error {
+	if m.repositories.Save == nil {
+		return nil
+	}
+
+	saved, err := m.repositories.Save.Load()
+	if err == nil && saved != nil {
+		if run, err := ResumeGameInteractor(*saved, m.repositories); err == nil {
+			run.AbandonRun()
+			return nil
+		}
+	}
+	return m.repositories.Save.Delete()
+}
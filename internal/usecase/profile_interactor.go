@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// ProfileInteractor はプロフィールの閲覧とリセットのユースケースを実装するのじゃ
+type ProfileInteractor struct {
+	repository ProfileRepository
+}
+
+// NewProfileInteractor はProfileInteractorのインスタンスを生成するのじゃ
+func NewProfileInteractor(repository ProfileRepository) *ProfileInteractor {
+	return &ProfileInteractor{repository: repository}
+}
+
+// GetProfile は現在のプロフィールを返すのじゃ
+func (p *ProfileInteractor) GetProfile() (entities.Profile, error) {
+	return p.repository.Load()
+}
+
+// ResetProfile はプロフィールを初期状態に戻すのじゃ
+// 経験値、解放済みのコンテンツ、アセンションが全て失われるのじゃ
+func (p *ProfileInteractor) ResetProfile() error {
+	return p.repository.Save(entities.NewProfile())
+}
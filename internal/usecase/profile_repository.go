@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// ProfileRepository はプロフィールを永続化するインターフェースを定義するのじゃ
+type ProfileRepository interface {
+	// Load はプロフィールを読み込むのじゃ。まだ無ければ新しいプロフィールを返すのじゃ
+	Load() (entities.Profile, error)
+	// Save はプロフィールを保存するのじゃ
+	Save(profile entities.Profile) error
+}
@@ -0,0 +1,11 @@
+package usecase
+
+// Repositories はゲームが使う永続化先をまとめたものじゃ
+// 使わないものはnilのままでよいのじゃ
+type Repositories struct {
+	History     HistoryRepository
+	Profile     ProfileRepository
+	Leaderboard LeaderboardRepository
+	Save        SaveRepository
+	CombatLog   CombatLogRepository
+}
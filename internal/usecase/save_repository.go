@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// SaveRepository は中断したランを永続化するインターフェースを定義するのじゃ
+type SaveRepository interface {
+	// Load は中断したランを読み込むのじゃ。無ければnilを返すのじゃ
+	Load() (*entities.SavedRun, error)
+	// Save は中断したランを保存するのじゃ
+	Save(run entities.SavedRun) error
+	// Delete は中断したランを消すのじゃ
+	Delete() error
+}
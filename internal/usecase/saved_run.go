@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yanosea/cts/internal/domain/entities"
+	"github.com/yanosea/cts/internal/i18n"
+)
+
+// autosave は現在のランを中断データとして保存するのじゃ
+// inRoomがtrueなら現在のノードに入った直後として保存するのじゃ
+func (i *GameInteractor) autosave(inRoom bool) {
+	if i.repositories.Save == nil || i.recorded {
+		return
+	}
+	if err := i.repositories.Save.Save(i.snapshot(inRoom)); err != nil {
+		i.SaveError = err
+	}
+}
+
+// snapshot は現在のランを中断データに変換するのじゃ
+func (i *GameInteractor) snapshot(inRoom bool) entities.SavedRun {
+	saved := entities.SavedRun{
+		Seed:         i.Seed,
+		Character:    i.Character.ID,
+		Ascension:    i.Ascension.Level,
+		PlayerName:   i.PlayerName,
+		Path:         i.GameMap.VisitedColumns(),
+		InRoom:       inRoom,
+		Health:       i.Player.Health,
+		MaxHealth:    i.Player.MaxHealth,
+		Gold:         i.Player.Gold,
+		PotionSlots:  i.Player.PotionSlots,
+		CostModifier: i.Player.CostModifier,
+		Deck:         []string{},
+		Relics:       []string{},
+		Potions:      []string{},
+		Stats:        i.Stats,
+		Elapsed:      time.Since(i.StartedAt),
+		Log:          append([]entities.LogEntry{}, i.CombatLog.Entries...),
+	}
+	if i.Daily != nil {
+		saved.DailyDate = i.Daily.Date
+	}
+	for _, card := range i.Player.Deck {
+		saved.Deck = append(saved.Deck, card.ID)
+	}
+	for _, relic := range i.Player.Relics {
+		saved.Relics = append(saved.Relics, relic.ID)
+	}
+	for _, potion := range i.Player.Potions {
+		saved.Potions = append(saved.Potions, potion.ID)
+	}
+
+	// 報酬の画面や店の中なら、残っている報酬や品ぞろえも残すのじゃ
+	switch i.State {
+	case entities.StateReward:
+		for _, reward := range i.Rewards {
+			saved.Rewards = append(saved.Rewards, saveReward(reward))
+		}
+	case entities.StateShop:
+		if i.Shop != nil {
+			for _, item := range i.Shop.Items {
+				saved.Shop = append(saved.Shop, saveShopItem(item))
+			}
+		}
+	}
+	return saved
+}
+
+// saveReward は報酬を保存する形にするのじゃ
+func saveReward(reward entities.Reward) entities.SavedReward {
+	saved := entities.SavedReward{Kind: reward.Kind, Gold: reward.Gold, Potion: reward.Potion.ID, Relic: reward.Relic.ID}
+	for _, card := range reward.Cards {
+		saved.Cards = append(saved.Cards, card.ID)
+	}
+	return saved
+}
+
+// saveShopItem は店の商品を保存する形にするのじゃ
+func saveShopItem(item entities.ShopItem) entities.SavedShopItem {
+	saved := entities.SavedShopItem{Kind: item.Kind, Price: item.Price, Sold: item.Sold}
+	switch item.Kind {
+	case entities.ShopCard:
+		saved.ID = item.Card.ID
+	case entities.ShopRelic:
+		saved.ID = item.Relic.ID
+	case entities.ShopPotion:
+		saved.ID = item.Potion.ID
+	}
+	return saved
+}
+
+// ResumeGameInteractor は中断データからランを再開するのじゃ
+// 報酬の画面や店の中で中断していたら、残っていた報酬や品ぞろえのままそこから再開するのじゃ
+// それ以外の部屋の中で中断していたら、その部屋に入った直後からやり直すのじゃ
+func ResumeGameInteractor(saved entities.SavedRun, repositories Repositories) (*GameInteractor, error) {
+	character, ok := entities.GetCharacterByID(saved.Character)
+	if !ok {
+		return nil, errors.New(i18n.T("error.unknown_character", saved.Character))
+	}
+
+	options := RunOptions{
+		Seed:       saved.Seed,
+		Ascension:  saved.Ascension,
+		PlayerName: saved.PlayerName,
+	}
+	if saved.DailyDate != "" {
+		daily, err := entities.ParseDailyChallenge(saved.DailyDate)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T("error.daily_date"), err)
+		}
+		options.Daily = &daily
+	}
+
+	i := NewGameInteractor(options, repositories)
+	if !i.GameMap.RestorePath(saved.Path) {
+		return nil, errors.New(i18n.T("error.saved_map_position"))
+	}
+	i.setCharacter(character)
+	i.Player = restorePlayer(character, saved)
+	i.Player.Log = i.CombatLog
+	i.Stats = saved.Stats
+	i.StartedAt = time.Now().Add(-saved.Elapsed)
+	i.CombatLog.Entries = append(i.CombatLog.Entries, saved.Log...)
+
+	switch {
+	case len(saved.Rewards) > 0:
+		i.reseedForFloor(i.GameMap.CurrentNode.Position.Floor)
+		for _, reward := range saved.Rewards {
+			i.Rewards = append(i.Rewards, restoreReward(reward))
+		}
+		i.State = entities.StateReward
+	case len(saved.Shop) > 0:
+		i.reseedForFloor(i.GameMap.CurrentNode.Position.Floor)
+		i.Shop = &entities.Shop{Items: []entities.ShopItem{}}
+		for _, item := range saved.Shop {
+			if restored, ok := restoreShopItem(item); ok {
+				i.Shop.Items = append(i.Shop.Items, restored)
+			}
+		}
+		i.State = entities.StateShop
+	case saved.InRoom:
+		i.enterCurrentNode()
+	default:
+		i.reseedForFloor(i.GameMap.CurrentNode.Position.Floor)
+		i.State = entities.StateMap
+	}
+
+	return i, nil
+}
+
+// restoreReward は保存しておいた報酬を元に戻すのじゃ
+// 今は無いカードは選べる札から除くのじゃ
+func restoreReward(saved entities.SavedReward) entities.Reward {
+	reward := entities.Reward{Kind: saved.Kind, Gold: saved.Gold, Cards: []entities.Card{}}
+	if potion, ok := entities.CreatePotionByID(saved.Potion); ok {
+		reward.Potion = potion
+	}
+	if relic, ok := entities.CreateRelicByID(saved.Relic); ok {
+		reward.Relic = relic
+	}
+	for _, id := range saved.Cards {
+		if card, ok := entities.CreateCardByID(id); ok {
+			reward.Cards = append(reward.Cards, card)
+		}
+	}
+	return reward
+}
+
+// restoreShopItem は保存しておいた店の商品を元に戻すのじゃ。今は無い商品ならfalseじゃ
+func restoreShopItem(saved entities.SavedShopItem) (entities.ShopItem, bool) {
+	item := entities.ShopItem{Kind: saved.Kind, Price: saved.Price, Sold: saved.Sold}
+	ok := false
+	switch saved.Kind {
+	case entities.ShopCard:
+		item.Card, ok = entities.CreateCardByID(saved.ID)
+	case entities.ShopRelic:
+		item.Relic, ok = entities.CreateRelicByID(saved.ID)
+	case entities.ShopPotion:
+		item.Potion, ok = entities.CreatePotionByID(saved.ID)
+	}
+	return item, ok
+}
+
+// restorePlayer は中断データからプレイヤーを復元するのじゃ
+// レリックを手に入れたときの効果はもう反映済みなので、改めて実行しないのじゃ
+func restorePlayer(character entities.Character, saved entities.SavedRun) *entities.Player {
+	player := entities.NewPlayer(character)
+	player.Health = saved.Health
+	player.MaxHealth = saved.MaxHealth
+	player.Gold = saved.Gold
+	player.PotionSlots = saved.PotionSlots
+	player.CostModifier = saved.CostModifier
+
+	for _, id := range saved.Deck {
+		if card, ok := entities.CreateCardByID(id); ok {
+			player.Deck = append(player.Deck, card)
+		}
+	}
+	for _, id := range saved.Relics {
+		if relic, ok := entities.CreateRelicByID(id); ok {
+			player.Relics = append(player.Relics, relic)
+		}
+	}
+	for _, id := range saved.Potions {
+		if potion, ok := entities.CreatePotionByID(id); ok {
+			player.Potions = append(player.Potions, potion)
+		}
+	}
+
+	return player
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// SettingsInteractor は設定の読み込みと保存のユースケースを実装するのじゃ
+type SettingsInteractor struct {
+	repository SettingsRepository
+}
+
+// NewSettingsInteractor はSettingsInteractorのインスタンスを生成するのじゃ
+// 保存先が無ければ設定は保存せず、既定の設定を使うのじゃ
+func NewSettingsInteractor(repository SettingsRepository) *SettingsInteractor {
+	return &SettingsInteractor{repository: repository}
+}
+
+// GetSettings は保存してある設定を返すのじゃ
+func (s *SettingsInteractor) GetSettings() (entities.Settings, error) {
+	if s.repository == nil {
+		return entities.NewSettings(), nil
+	}
+	return s.repository.Load()
+}
+
+// SaveSettings は設定を保存するのじゃ
+func (s *SettingsInteractor) SaveSettings(settings entities.Settings) error {
+	if s.repository == nil {
+		return nil
+	}
+	return s.repository.Save(settings)
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"github.com/yanosea/cts/internal/domain/entities"
+)
+
+// SettingsRepository は設定を永続化するインターフェースを定義するのじゃ
+type SettingsRepository interface {
+	// Load は設定を読み込むのじゃ。まだ無ければ既定の設定を返すのじゃ
+	Load() (entities.Settings, error)
+	// Save は設定を保存するのじゃ
+	Save(settings entities.Settings) error
+}